@@ -31,8 +31,8 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 
-	"github.com/eupholio/eupholio/models"
 	"github.com/eupholio/eupholio/pkg/cmdutil"
+	"github.com/eupholio/eupholio/pkg/etlcmd"
 )
 
 func main() {
@@ -54,7 +54,12 @@ var rootCmd = &cobra.Command{
 }
 
 func init() {
-	rootCmd.AddCommand(configCostMethodCmd())
+	cmdutil.AddConfigFlag(rootCmd)
+	cmdutil.AddPortfolioFlag(rootCmd)
+	rootCmd.AddCommand(
+		configCostMethodCmd(),
+		configPortfolioCmd(),
+	)
 }
 
 // Execute runs root command
@@ -66,9 +71,9 @@ func Execute() error {
 	return nil
 }
 
-// OpenDB opens a database with default settings
-func OpenDB() (*sql.DB, error) {
-	return cmdutil.OpenDB()
+// OpenDB opens the database of the config of a command
+func OpenDB(cmd *cobra.Command) (*sql.DB, error) {
+	return cmdutil.OpenDB(cmd)
 }
 
 // WithTx runs fn with a transaction
@@ -81,7 +86,7 @@ func configCostMethodCmd() *cobra.Command {
 		Use:   "costmethod",
 		Short: "set cost calcuration method",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			db, err := OpenDB()
+			db, err := OpenDB(cmd)
 			if err != nil {
 				return err
 			}
@@ -93,27 +98,53 @@ func configCostMethodCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			ctx := context.Background()
+			ctx, _, err := cmdutil.PortfolioContext(cmd, db)
+			if err != nil {
+				return err
+			}
 			return WithTx(ctx, db, func(tx *sql.Tx) error {
-				c, err := models.FindConfig(ctx, tx, 0, year)
-				if err == sql.ErrNoRows {
-					c = &models.Config{
-						ID:         0,
-						Year:       year,
-						CostMethod: method,
-					}
-					return c.Insert(ctx, tx, boil.Infer())
-				}
-				c.CostMethod = method
-				_, err = c.Update(ctx, tx, boil.Infer())
+				return etlcmd.SetCostMethod(ctx, tx, year, method)
+			})
+		},
+	}
+	cmd.Flags().Int("year", 0, "year")
+	cmd.Flags().String("method", "", "method")
+	return cmd
+}
+
+func configPortfolioCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "portfolio name",
+		Short: "create a portfolio or set its fiat currency and timezone",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db, err := OpenDB(cmd)
+			if err != nil {
+				return err
+			}
+			fiat, err := cmd.Flags().GetString("fiat")
+			if err != nil {
+				return err
+			}
+			timezone, err := cmd.Flags().GetString("timezone")
+			if err != nil {
+				return err
+			}
+			ctx, err := cmdutil.Context(cmd)
+			if err != nil {
+				return err
+			}
+			return WithTx(ctx, db, func(tx *sql.Tx) error {
+				p, err := etlcmd.SetPortfolio(ctx, tx, args[0], fiat, timezone)
 				if err != nil {
 					return err
 				}
+				log.Printf("portfolio %s (id %d): fiat %s, timezone %s", p.Name, p.ID, p.Fiat, p.Timezone)
 				return nil
 			})
 		},
 	}
-	cmd.Flags().Int("year", 0, "year")
-	cmd.Flags().String("method", "", "method")
+	cmd.Flags().String("fiat", "", "fiat currency (the fiat of the config for new portfolios if empty)")
+	cmd.Flags().String("timezone", "", "timezone of years (the timezone of the config for new portfolios if empty)")
 	return cmd
 }
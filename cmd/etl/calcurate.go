@@ -21,12 +21,11 @@
 package main
 
 import (
-	"context"
 	"database/sql"
-	"time"
 
 	"github.com/spf13/cobra"
 
+	"github.com/eupholio/eupholio/pkg/cmdutil"
 	"github.com/eupholio/eupholio/pkg/costmethod"
 	"github.com/eupholio/eupholio/pkg/currency"
 	"github.com/eupholio/eupholio/pkg/etlcmd"
@@ -34,7 +33,6 @@ import (
 
 // CalculateCmd imports data from files
 func CalculateCmd() *cobra.Command {
-	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
 	cmd := &cobra.Command{
 		Use:   "calculate",
 		Short: "calculate profit",
@@ -43,11 +41,19 @@ func CalculateCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			fiat, err := cmd.Flags().GetString("fiat")
+			method, err := cmd.Flags().GetString("method")
 			if err != nil {
 				return err
 			}
-			method, err := cmd.Flags().GetString("method")
+			verifyWith, err := cmd.Flags().GetString("verify-with")
+			if err != nil {
+				return err
+			}
+			tolerance, err := cmd.Flags().GetString("verify-tolerance")
+			if err != nil {
+				return err
+			}
+			warnOnly, err := cmd.Flags().GetBool("verify-warn-only")
 			if err != nil {
 				return err
 			}
@@ -58,20 +64,37 @@ func CalculateCmd() *cobra.Command {
 				options = append(options, costmethod.DebugOption())
 			}
 
-			db, err := OpenDB()
+			db, err := OpenDB(cmd)
 			if err != nil {
 				return err
 			}
 
-			ctx := context.Background()
+			ctx, portfolio, err := cmdutil.PortfolioContext(cmd, db)
+			if err != nil {
+				return err
+			}
+			fiat, loc, err := cmdutil.FiatAndLocation(cmd, portfolio)
+			if err != nil {
+				return err
+			}
 			return WithTx(ctx, db, func(tx *sql.Tx) error {
-				return etlcmd.Calculate(ctx, tx, year, currency.Symbol(fiat), jst, method, options...)
+				err := etlcmd.Calculate(ctx, tx, year, currency.Symbol(fiat), loc, method, options...)
+				if err != nil {
+					return err
+				}
+				if verifyWith == "" {
+					return nil
+				}
+				return etlcmd.Verify(ctx, tx, year, currency.Symbol(fiat), loc, method, verifyWith, tolerance, warnOnly)
 			})
 		},
 	}
 	cmd.Flags().Bool("debug", false, "debug")
-	cmd.Flags().Int("year", 0, "year")
-	cmd.Flags().String("fiat", "JPY", "fiat currency ticker code")
+	cmd.Flags().Int("year", 0, "year recalculated even if up to date (changed years only if 0)")
+	cmd.Flags().String("fiat", "", "fiat currency (the fiat of the portfolio if empty)")
 	cmd.Flags().String("method", "", "override cost calculation method (wam, mam)")
+	cmd.Flags().String("verify-with", "", "path to eupholio-core-cli to verify the result with")
+	cmd.Flags().String("verify-tolerance", "1", "tolerance of profits in fiat currency")
+	cmd.Flags().Bool("verify-warn-only", false, "log differences without failing")
 	return cmd
 }
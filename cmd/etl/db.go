@@ -0,0 +1,112 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/eupholio/eupholio/pkg/cmdutil"
+	"github.com/eupholio/eupholio/pkg/etlcmd"
+	"github.com/eupholio/eupholio/pkg/migration"
+)
+
+// DBCmd manages the schema of the database by migrations
+func DBCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "db",
+		Short: "manage the schema of the database",
+	}
+	cmd.AddCommand(
+		dbMigrateCmd(),
+		dbStatusCmd(),
+		dbRollbackCmd(),
+	)
+	return cmd
+}
+
+func dbMigrateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "apply pending migrations, which keeps rows of existing tables",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			to, err := cmd.Flags().GetInt("to")
+			if err != nil {
+				return err
+			}
+			driver, err := cmdutil.DBDriver(cmd)
+			if err != nil {
+				return err
+			}
+			db, err := OpenDB(cmd)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			return etlcmd.MigrateDB(context.Background(), db, migration.Dialect(driver), to)
+		},
+	}
+	cmd.Flags().Int("to", 0, "version migrated up to. all pending migrations are applied if 0")
+	return cmd
+}
+
+func dbStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "show applied and pending migrations",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db, err := OpenDB(cmd)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			return etlcmd.PrintDBStatus(context.Background(), os.Stdout, db)
+		},
+	}
+	return cmd
+}
+
+func dbRollbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "roll back the last applied migrations. the baseline cannot be rolled back",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			steps, err := cmd.Flags().GetInt("steps")
+			if err != nil {
+				return err
+			}
+			driver, err := cmdutil.DBDriver(cmd)
+			if err != nil {
+				return err
+			}
+			db, err := OpenDB(cmd)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			return etlcmd.RollbackDB(context.Background(), db, migration.Dialect(driver), steps)
+		},
+	}
+	cmd.Flags().Int("steps", 1, "number of migrations rolled back")
+	return cmd
+}
@@ -0,0 +1,245 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package main
+
+import (
+	"database/sql"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/eupholio/eupholio/pkg/cmdutil"
+	"github.com/eupholio/eupholio/pkg/currency"
+	"github.com/eupholio/eupholio/pkg/etlcmd"
+)
+
+// ExportCmd exports data to files
+func ExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "export data",
+	}
+	cmd.AddCommand(
+		exportCryptactCmd(),
+		exportKoinlyCmd(),
+		exportCointrackingCmd(),
+		exportCoreInputCmd(),
+	)
+	return cmd
+}
+
+func exportCryptactCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cryptact",
+		Short: "export events as a cryptact custom file",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			year, err := cmd.Flags().GetInt("year")
+			if err != nil {
+				return err
+			}
+			timezone, err := cmd.Flags().GetString("timezone")
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			w, closeFn, err := openOutput(output)
+			if err != nil {
+				return err
+			}
+			defer closeFn()
+			db, err := OpenDB(cmd)
+			if err != nil {
+				return err
+			}
+			ctx, portfolio, err := cmdutil.PortfolioContext(cmd, db)
+			if err != nil {
+				return err
+			}
+			fiat, loc, err := cmdutil.FiatAndLocation(cmd, portfolio)
+			if err != nil {
+				return err
+			}
+			return WithTx(ctx, db, func(tx *sql.Tx) error {
+				return etlcmd.ExportCryptactData(ctx, tx, w, year, loc, currency.Symbol(fiat), timezone)
+			})
+		},
+	}
+	cmd.Flags().Int("year", 0, "year")
+	cmd.Flags().String("fiat", "", "fiat currency (the fiat of the portfolio if empty)")
+	cmd.Flags().String("timezone", "UTC", "timezone (UTC)")
+	cmd.Flags().String("output", "-", "output file (- for stdout)")
+	return cmd
+}
+
+func exportKoinlyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "koinly",
+		Short: "export events as a koinly universal file",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			year, err := cmd.Flags().GetInt("year")
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			w, closeFn, err := openOutput(output)
+			if err != nil {
+				return err
+			}
+			defer closeFn()
+			db, err := OpenDB(cmd)
+			if err != nil {
+				return err
+			}
+			ctx, portfolio, err := cmdutil.PortfolioContext(cmd, db)
+			if err != nil {
+				return err
+			}
+			fiat, loc, err := cmdutil.FiatAndLocation(cmd, portfolio)
+			if err != nil {
+				return err
+			}
+			return WithTx(ctx, db, func(tx *sql.Tx) error {
+				return etlcmd.ExportKoinlyData(ctx, tx, w, year, loc, currency.Symbol(fiat))
+			})
+		},
+	}
+	cmd.Flags().Int("year", 0, "year")
+	cmd.Flags().String("fiat", "", "fiat currency (the fiat of the portfolio if empty)")
+	cmd.Flags().String("output", "-", "output file (- for stdout)")
+	return cmd
+}
+
+func exportCointrackingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cointracking",
+		Short: "export events as a cointracking trade list",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			year, err := cmd.Flags().GetInt("year")
+			if err != nil {
+				return err
+			}
+			timezone, err := cmd.Flags().GetString("timezone")
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			w, closeFn, err := openOutput(output)
+			if err != nil {
+				return err
+			}
+			defer closeFn()
+			db, err := OpenDB(cmd)
+			if err != nil {
+				return err
+			}
+			ctx, portfolio, err := cmdutil.PortfolioContext(cmd, db)
+			if err != nil {
+				return err
+			}
+			fiat, loc, err := cmdutil.FiatAndLocation(cmd, portfolio)
+			if err != nil {
+				return err
+			}
+			return WithTx(ctx, db, func(tx *sql.Tx) error {
+				return etlcmd.ExportCointrackingData(ctx, tx, w, year, loc, currency.Symbol(fiat), timezone)
+			})
+		},
+	}
+	cmd.Flags().Int("year", 0, "year")
+	cmd.Flags().String("fiat", "", "fiat currency (the fiat of the portfolio if empty)")
+	cmd.Flags().String("timezone", "UTC", "timezone (UTC)")
+	cmd.Flags().String("output", "-", "output file (- for stdout)")
+	return cmd
+}
+
+func exportCoreInputCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "core-input",
+		Short: "export calculated entries as an input of eupholio-core-cli",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			year, err := cmd.Flags().GetInt("year")
+			if err != nil {
+				return err
+			}
+			method, err := cmd.Flags().GetString("method")
+			if err != nil {
+				return err
+			}
+			splitIncome, err := cmd.Flags().GetBool("split-income")
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			w, closeFn, err := openOutput(output)
+			if err != nil {
+				return err
+			}
+			defer closeFn()
+			db, err := OpenDB(cmd)
+			if err != nil {
+				return err
+			}
+			ctx, portfolio, err := cmdutil.PortfolioContext(cmd, db)
+			if err != nil {
+				return err
+			}
+			fiat, loc, err := cmdutil.FiatAndLocation(cmd, portfolio)
+			if err != nil {
+				return err
+			}
+			return WithTx(ctx, db, func(tx *sql.Tx) error {
+				return etlcmd.ExportCoreInputData(ctx, tx, w, year, loc, currency.Symbol(fiat), method, splitIncome)
+			})
+		},
+	}
+	cmd.Flags().Int("year", 0, "year")
+	cmd.Flags().String("fiat", "", "fiat currency (the fiat of the portfolio if empty)")
+	cmd.Flags().String("method", "", "cost method (wam, mam), the configured method of the year by default")
+	cmd.Flags().Bool("split-income", false, "export incomes as acquisitions and disposals as eupholio calculates them")
+	cmd.Flags().String("output", "-", "output file (- for stdout)")
+	cmd.MarkFlagRequired("year")
+	return cmd
+}
+
+// openOutput opens a file to write, or stdout for "-"
+func openOutput(path string) (io.Writer, func() error, error) {
+	if path == "-" {
+		return os.Stdout, func() error { return nil }, nil
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return f, f.Close, nil
+}
@@ -21,12 +21,19 @@
 package main
 
 import (
-	"context"
 	"database/sql"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
+	"github.com/eupholio/eupholio/pkg/bitflyer"
+	"github.com/eupholio/eupholio/pkg/cmdutil"
+	"github.com/eupholio/eupholio/pkg/currency"
 	"github.com/eupholio/eupholio/pkg/etlcmd"
+	"github.com/eupholio/eupholio/pkg/eupholio"
 )
 
 // ImportCmd imports data from files
@@ -35,147 +42,229 @@ func ImportCmd() *cobra.Command {
 		Use:   "import",
 		Short: "import data",
 	}
+	for _, e := range eupholio.Exchanges() {
+		if len(e.FileTypes) > 0 {
+			cmd.AddCommand(importExchangeCmd(e))
+		}
+	}
 	cmd.AddCommand(
-		importBitflyerCmd(),
-		importCoincheckCmd(),
-		importBittrexCmd(),
-		importPoloniexCmd(),
-		importCryptactCmd(),
+		importAutoCmd(),
+		importBitflyerAPICmd(),
+		importNormalizedCmd(),
+		importUndoCmd(),
 	)
 	return cmd
 }
 
-func importBitflyerCmd() *cobra.Command {
+func importAutoCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "bf",
-		Short: "import bitFlyer data",
+		Use:   "auto",
+		Short: "import files detecting their types by headers",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			overwrite, err := cmd.Flags().GetBool("overwrite")
 			if err != nil {
 				return err
 			}
-			db, err := OpenDB()
+			lenient, err := cmd.Flags().GetBool("lenient")
+			if err != nil {
+				return err
+			}
+			timezone, err := cmd.Flags().GetString("timezone")
+			if err != nil {
+				return err
+			}
+			account, err := cmd.Flags().GetString("account")
+			if err != nil {
+				return err
+			}
+			db, err := OpenDB(cmd)
+			if err != nil {
+				return err
+			}
+			ctx, _, err := cmdutil.PortfolioContext(cmd, db)
 			if err != nil {
 				return err
 			}
-			ctx := context.Background()
 			return WithTx(ctx, db, func(tx *sql.Tx) error {
-				return etlcmd.ImportBitflyerData(ctx, db, args, overwrite)
+				return etlcmd.ImportAutoData(ctx, tx, args, overwrite, lenient, timezone, account)
 			})
 		},
 	}
 	cmd.Flags().Bool("overwrite", false, "overwrite")
+	cmd.Flags().Bool("lenient", false, "import valid rows and quarantine invalid ones instead of failing")
+	cmd.Flags().String("account", "", "account label of the imported data, which tells accounts of an exchange apart")
+	var names []string
+	for _, e := range eupholio.Exchanges() {
+		if e.Timezone {
+			names = append(names, e.Name)
+		}
+	}
+	cmd.Flags().String("timezone", "UTC", fmt.Sprintf("timezone of %s files (UTC)", strings.Join(names, " and ")))
 	return cmd
 }
 
-func importCoincheckCmd() *cobra.Command {
+// importExchangeCmd imports files of an exchange registered in eupholio
+func importExchangeCmd(e *eupholio.Exchange) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "coincheck",
-		Short: "import coincheck data",
+		Use:     e.Name,
+		Aliases: e.Aliases,
+		Short:   fmt.Sprintf("import %s data", e.DisplayName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			overwrite, err := cmd.Flags().GetBool("overwrite")
 			if err != nil {
 				return err
 			}
-			db, err := OpenDB()
+			lenient, err := cmd.Flags().GetBool("lenient")
+			if err != nil {
+				return err
+			}
+			account, err := cmd.Flags().GetString("account")
+			if err != nil {
+				return err
+			}
+			var filetype string
+			if f := cmd.Flags().Lookup("filetype"); f != nil {
+				filetype = f.Value.String()
+			}
+			timezone := "UTC"
+			if f := cmd.Flags().Lookup("timezone"); f != nil {
+				timezone = f.Value.String()
+			}
+			db, err := OpenDB(cmd)
+			if err != nil {
+				return err
+			}
+			ctx, _, err := cmdutil.PortfolioContext(cmd, db)
 			if err != nil {
 				return err
 			}
-			ctx := context.Background()
 			return WithTx(ctx, db, func(tx *sql.Tx) error {
-				return etlcmd.ImportCoincheckData(ctx, db, args, overwrite)
+				return etlcmd.ImportData(ctx, tx, e.Name, args, overwrite, lenient, filetype, timezone, account)
 			})
 		},
 	}
 	cmd.Flags().Bool("overwrite", false, "overwrite")
+	cmd.Flags().Bool("lenient", false, "import valid rows and quarantine invalid ones instead of failing")
+	cmd.Flags().String("account", "", "account label of the imported data, which tells accounts of an exchange apart")
+	if e.DefaultFileType != "" || len(e.FileTypes) > 1 {
+		usage := fmt.Sprintf("file type (%s)", strings.Join(e.FileTypeNames(), ", "))
+		if e.DefaultFileType == "" {
+			usage += ", detected by the header if not specified"
+		}
+		cmd.Flags().String("filetype", e.DefaultFileType, usage)
+	}
+	if e.Timezone {
+		cmd.Flags().String("timezone", "UTC", "timezone (UTC)")
+	}
 	return cmd
 }
 
-func importBittrexCmd() *cobra.Command {
+func importBitflyerAPICmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "bittrex",
-		Short: "import bittrex data",
+		Use:   "bf-api",
+		Short: "import bitFlyer data from the API (BITFLYER_API_KEY and BITFLYER_API_SECRET are required)",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			overwrite, err := cmd.Flags().GetBool("overwrite")
+			baseURL, err := cmd.Flags().GetString("base-url")
+			if err != nil {
+				return err
+			}
+			productCodes, err := cmd.Flags().GetStringSlice("product-code")
 			if err != nil {
 				return err
 			}
-			filetype, err := cmd.Flags().GetString("filetype")
+			account, err := cmd.Flags().GetString("account")
 			if err != nil {
 				return err
 			}
-			db, err := OpenDB()
+			db, err := OpenDB(cmd)
+			if err != nil {
+				return err
+			}
+			key := os.Getenv("BITFLYER_API_KEY")
+			secret := os.Getenv("BITFLYER_API_SECRET")
+			ctx, _, err := cmdutil.PortfolioContext(cmd, db)
 			if err != nil {
 				return err
 			}
-			ctx := context.Background()
 			return WithTx(ctx, db, func(tx *sql.Tx) error {
-				return etlcmd.ImportBittrexData(ctx, db, args, overwrite, filetype)
+				return etlcmd.ImportBitflyerAPIData(ctx, tx, baseURL, key, secret, productCodes, account)
 			})
 		},
 	}
-	cmd.Flags().Bool("overwrite", false, "overwrite")
-	cmd.Flags().String("filetype", "order", "file type (order, deposit, withdraw)")
+	cmd.Flags().String("base-url", bitflyer.DefaultBaseURL, "base URL of the API")
+	cmd.Flags().StringSlice("product-code", []string{"BTC_JPY"}, "spot products whose executions are imported")
+	cmd.Flags().String("account", "", "account label of the imported data, which tells accounts of an exchange apart")
 	return cmd
 }
 
-func importPoloniexCmd() *cobra.Command {
+func importNormalizedCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "poloniex",
-		Short: "import poloniex data",
+		Use:   "normalized",
+		Short: "import events normalized by eupholio-normalizer (JSON)",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			overwrite, err := cmd.Flags().GetBool("overwrite")
 			if err != nil {
 				return err
 			}
-			filetype, err := cmd.Flags().GetString("filetype")
+			lenient, err := cmd.Flags().GetBool("lenient")
 			if err != nil {
 				return err
 			}
-			db, err := OpenDB()
+			wallet, err := cmd.Flags().GetString("wallet")
+			if err != nil {
+				return err
+			}
+			account, err := cmd.Flags().GetString("account")
+			if err != nil {
+				return err
+			}
+			db, err := OpenDB(cmd)
+			if err != nil {
+				return err
+			}
+			ctx, _, err := cmdutil.PortfolioContext(cmd, db)
 			if err != nil {
 				return err
 			}
-			ctx := context.Background()
 			return WithTx(ctx, db, func(tx *sql.Tx) error {
-				return etlcmd.ImportPoloniexData(ctx, db, args, overwrite, filetype)
+				return etlcmd.ImportNormalizedData(ctx, tx, args, overwrite, lenient, wallet, account)
 			})
 		},
 	}
-	cmd.Flags().Bool("overwrite", false, "overwrite")
-	cmd.Flags().String("filetype", "", "file type (trades, deposits, withdrawals, distributions)")
+	cmd.Flags().Bool("overwrite", false, "import files even if they have been imported")
+	cmd.Flags().Bool("lenient", false, "import valid events and quarantine invalid ones instead of failing")
+	cmd.Flags().String("wallet", "NORMALIZED", "wallet shown in descriptions of the transactions, which tells outputs of the normalizer apart (up to 10 characters)")
+	cmd.Flags().String("account", "", "account label of the imported data, which tells accounts of an exchange apart")
 	return cmd
 }
 
-func importCryptactCmd() *cobra.Command {
+func importUndoCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "cryptact",
-		Short: "import cryptact data",
+		Use:   "undo <batch id>",
+		Short: "delete rows imported in a batch (see query batch)",
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			overwrite, err := cmd.Flags().GetBool("overwrite")
+			id, err := strconv.Atoi(args[0])
 			if err != nil {
-				return err
+				return fmt.Errorf("invalid batch id %s", args[0])
 			}
-			filetype, err := cmd.Flags().GetString("filetype")
+			db, err := OpenDB(cmd)
 			if err != nil {
 				return err
 			}
-			timezone, err := cmd.Flags().GetString("timezone")
+			ctx, portfolio, err := cmdutil.PortfolioContext(cmd, db)
 			if err != nil {
 				return err
 			}
-			db, err := OpenDB()
+			fiat, loc, err := cmdutil.FiatAndLocation(cmd, portfolio)
 			if err != nil {
 				return err
 			}
-			ctx := context.Background()
 			return WithTx(ctx, db, func(tx *sql.Tx) error {
-				return etlcmd.ImportCryptactData(ctx, db, args, overwrite, filetype, timezone)
+				return etlcmd.UndoImportBatch(ctx, tx, id, loc, currency.Symbol(fiat))
 			})
 		},
 	}
-	cmd.Flags().Bool("overwrite", false, "overwrite")
-	cmd.Flags().String("filetype", "custom", "file type (custom)")
-	cmd.Flags().String("timezone", "UTC", "timezone (UTC)")
+	cmd.Flags().String("fiat", "", "fiat currency of translation (the fiat of the portfolio if empty)")
 	return cmd
 }
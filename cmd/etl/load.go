@@ -59,13 +59,13 @@ func loadCoingeckoHistoricalPriceCmd() *cobra.Command {
 		Use:   "historical_price",
 		Short: "load Coingecko historical price data",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			db, err := OpenDB()
+			db, err := OpenDB(cmd)
 			if err != nil {
 				return err
 			}
 			ctx := context.Background()
 			return WithTx(ctx, db, func(tx *sql.Tx) error {
-				return etlcmd.LoadCoingeckoHistoricalPrice(db, args)
+				return etlcmd.LoadCoingeckoHistoricalPrice(tx, args)
 			})
 		},
 	}
@@ -87,13 +87,13 @@ func loadYahooFinanceHistoricalPriceCmd() *cobra.Command {
 		Use:   "historical_price",
 		Short: "load Yahoo Finance historical price data",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			db, err := OpenDB()
+			db, err := OpenDB(cmd)
 			if err != nil {
 				return err
 			}
 			ctx := context.Background()
 			return WithTx(ctx, db, func(tx *sql.Tx) error {
-				return etlcmd.LoadYahooFinanceHistoricalPrice(db, args)
+				return etlcmd.LoadYahooFinanceHistoricalPrice(tx, args)
 			})
 		},
 	}
@@ -115,7 +115,7 @@ func loadCDDHistoricalPriceCmd() *cobra.Command {
 		Use:   "historical_price",
 		Short: "load CryptoDataDownload historical price data",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			db, err := OpenDB()
+			db, err := OpenDB(cmd)
 			if err != nil {
 				return err
 			}
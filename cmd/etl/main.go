@@ -53,12 +53,17 @@ var rootCmd = &cobra.Command{
 }
 
 func init() {
+	cmdutil.AddConfigFlag(rootCmd)
+	cmdutil.AddPortfolioFlag(rootCmd)
 	rootCmd.AddCommand(
 		LoadCmd(),
 		ImportCmd(),
 		CalculateCmd(),
 		TranslateCmd(),
 		DownloadCmd(),
+		ExportCmd(),
+		RunCmd(),
+		DBCmd(),
 	)
 }
 
@@ -71,9 +76,9 @@ func Execute() error {
 	return nil
 }
 
-// OpenDB opens a database with default settings
-func OpenDB() (*sql.DB, error) {
-	return cmdutil.OpenDB()
+// OpenDB opens the database of the config of a command
+func OpenDB(cmd *cobra.Command) (*sql.DB, error) {
+	return cmdutil.OpenDB(cmd)
 }
 
 // WithTx runs fn with a transaction
@@ -0,0 +1,66 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/eupholio/eupholio/pkg/cmdutil"
+	"github.com/eupholio/eupholio/pkg/etlcmd"
+	"github.com/eupholio/eupholio/pkg/manifest"
+)
+
+// RunCmd runs a pipeline described by a manifest
+func RunCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run manifest.yaml",
+		Short: "run the pipeline of a manifest (YAML or TOML)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			force, err := cmd.Flags().GetBool("force")
+			if err != nil {
+				return err
+			}
+			m, err := manifest.Load(args[0])
+			if err != nil {
+				return err
+			}
+			portfolio, err := cmd.Flags().GetString("portfolio")
+			if err != nil {
+				return err
+			}
+			if portfolio != "" {
+				m.Portfolio = portfolio
+			}
+			ctx, err := cmdutil.Context(cmd)
+			if err != nil {
+				return err
+			}
+			db, err := OpenDB(cmd)
+			if err != nil {
+				return err
+			}
+			return etlcmd.Run(ctx, db, m, force)
+		},
+	}
+	cmd.Flags().Bool("force", false, "run up-to-date stages too")
+	return cmd
+}
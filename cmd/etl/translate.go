@@ -21,20 +21,17 @@
 package main
 
 import (
-	"context"
 	"database/sql"
-	"time"
 
 	"github.com/spf13/cobra"
 
+	"github.com/eupholio/eupholio/pkg/cmdutil"
 	"github.com/eupholio/eupholio/pkg/currency"
 	"github.com/eupholio/eupholio/pkg/etlcmd"
 )
 
 // TranslateCmd imports data from files
 func TranslateCmd() *cobra.Command {
-	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
-
 	cmd := &cobra.Command{
 		Use:   "translate",
 		Short: "translate transaction data",
@@ -43,21 +40,24 @@ func TranslateCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			fiat, err := cmd.Flags().GetString("fiat")
+			db, err := OpenDB(cmd)
+			if err != nil {
+				return err
+			}
+			ctx, portfolio, err := cmdutil.PortfolioContext(cmd, db)
 			if err != nil {
 				return err
 			}
-			db, err := OpenDB()
+			fiat, loc, err := cmdutil.FiatAndLocation(cmd, portfolio)
 			if err != nil {
 				return err
 			}
-			ctx := context.Background()
 			return WithTx(ctx, db, func(tx *sql.Tx) error {
-				return etlcmd.Translate(ctx, tx, year, jst, currency.Symbol(fiat))
+				return etlcmd.Translate(ctx, tx, year, loc, currency.Symbol(fiat))
 			})
 		},
 	}
-	cmd.Flags().Int("year", 0, "year")
-	cmd.Flags().String("fiat", "JPY", "fiat currency")
+	cmd.Flags().Int("year", 0, "year (rows imported since the last translation if 0)")
+	cmd.Flags().String("fiat", "", "fiat currency (the fiat of the portfolio if empty)")
 	return cmd
 }
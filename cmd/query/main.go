@@ -25,18 +25,16 @@ import (
 	"database/sql"
 	"log"
 	"os"
-	"time"
 
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/spf13/cobra"
 
 	"github.com/eupholio/eupholio/pkg/cmdutil"
 	"github.com/eupholio/eupholio/pkg/currency"
+	"github.com/eupholio/eupholio/pkg/eupholio"
 	"github.com/eupholio/eupholio/pkg/querycmd"
 )
 
-var jst = time.FixedZone("Asia/Tokyo", 9*60*60)
-
 func main() {
 	err := Execute()
 	if err != nil {
@@ -51,10 +49,15 @@ var rootCmd = &cobra.Command{
 }
 
 func init() {
+	cmdutil.AddConfigFlag(rootCmd)
+	cmdutil.AddPortfolioFlag(rootCmd)
 	rootCmd.AddCommand(
 		SummarizeCmd(),
 		BalanceCmd(),
 		TransactionCmd(),
+		HoldingCmd(),
+		BatchCmd(),
+		QuarantineCmd(),
 	)
 }
 
@@ -88,22 +91,42 @@ func BalanceCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			account, err := cmd.Flags().GetString("account")
+			if err != nil {
+				return err
+			}
+			byAccount, err := cmd.Flags().GetBool("by-account")
+			if err != nil {
+				return err
+			}
 
 			w := os.Stdout
-			ctx := context.Background()
-			db, err := cmdutil.OpenDB()
+			db, err := cmdutil.OpenDB(cmd)
+			if err != nil {
+				return err
+			}
+			ctx, portfolio, err := cmdutil.PortfolioContext(cmd, db)
+			if err != nil {
+				return err
+			}
+			if symbol == "" {
+				symbol = portfolio.Fiat
+			}
+			loc, err := eupholio.PortfolioLocation(portfolio)
 			if err != nil {
 				return err
 			}
 			return WithTx(ctx, db, func(tx *sql.Tx) error {
-				return querycmd.QueryBalance(ctx, w, tx, year, currency.Symbol(symbol), source, querycmd.OutputFormat(format))
+				return querycmd.QueryBalance(ctx, w, tx, year, loc, currency.Symbol(symbol), source, account, byAccount, querycmd.OutputFormat(format))
 			})
 		},
 	}
 	cmd.Flags().Int("year", 0, "year")
-	cmd.Flags().String("symbol", "JPY", "base currency symbol")
+	cmd.Flags().String("symbol", "", "base currency symbol (the fiat of the portfolio if empty)")
 	cmd.Flags().String("source", "yahoofinance", "data source")
 	cmd.Flags().String("format", "table", "output format")
+	cmd.Flags().String("account", "", "show balances of the account only")
+	cmd.Flags().Bool("by-account", false, "show balances of each account")
 	return cmd
 }
 
@@ -128,22 +151,140 @@ func TransactionCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			account, err := cmd.Flags().GetString("account")
+			if err != nil {
+				return err
+			}
 
 			w := os.Stdout
-			ctx := context.Background()
-			db, err := cmdutil.OpenDB()
+			db, err := cmdutil.OpenDB(cmd)
+			if err != nil {
+				return err
+			}
+			ctx, portfolio, err := cmdutil.PortfolioContext(cmd, db)
+			if err != nil {
+				return err
+			}
+			if baseCurrency == "" {
+				baseCurrency = portfolio.Fiat
+			}
+			loc, err := eupholio.PortfolioLocation(portfolio)
 			if err != nil {
 				return err
 			}
 			return WithTx(ctx, db, func(tx *sql.Tx) error {
-				return querycmd.QueryTransactions(ctx, w, tx, year, jst, baseCurrency, source, querycmd.OutputFormat(format))
+				return querycmd.QueryTransactions(ctx, w, tx, year, loc, baseCurrency, source, account, querycmd.OutputFormat(format))
 			})
 		},
 	}
 	cmd.Flags().Int("year", 0, "year")
-	cmd.Flags().String("symbol", "JPY", "base currency symbol")
+	cmd.Flags().String("symbol", "", "base currency symbol (the fiat of the portfolio if empty)")
 	cmd.Flags().String("source", "yahoofinance", "data source")
 	cmd.Flags().String("format", "table", "output format")
+	cmd.Flags().String("account", "", "show transactions of the account only")
+	return cmd
+}
+
+func HoldingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "holding",
+		Short: "show quantities of currencies held in each account",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			year, err := cmd.Flags().GetInt("year")
+			if err != nil {
+				return err
+			}
+			account, err := cmd.Flags().GetString("account")
+			if err != nil {
+				return err
+			}
+			format, err := cmd.Flags().GetString("format")
+			if err != nil {
+				return err
+			}
+
+			w := os.Stdout
+			db, err := cmdutil.OpenDB(cmd)
+			if err != nil {
+				return err
+			}
+			ctx, portfolio, err := cmdutil.PortfolioContext(cmd, db)
+			if err != nil {
+				return err
+			}
+			loc, err := eupholio.PortfolioLocation(portfolio)
+			if err != nil {
+				return err
+			}
+			return WithTx(ctx, db, func(tx *sql.Tx) error {
+				return querycmd.QueryHoldings(ctx, w, tx, year, loc, account, querycmd.OutputFormat(format))
+			})
+		},
+	}
+	cmd.Flags().Int("year", 0, "year (now if 0)")
+	cmd.Flags().String("account", "", "show the account only")
+	cmd.Flags().String("format", "table", "output format")
+	return cmd
+}
+
+func BatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch",
+		Short: "show import batches",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := cmd.Flags().GetString("format")
+			if err != nil {
+				return err
+			}
+
+			w := os.Stdout
+			db, err := cmdutil.OpenDB(cmd)
+			if err != nil {
+				return err
+			}
+			ctx, _, err := cmdutil.PortfolioContext(cmd, db)
+			if err != nil {
+				return err
+			}
+			return WithTx(ctx, db, func(tx *sql.Tx) error {
+				return querycmd.QueryImportBatches(ctx, w, tx, querycmd.OutputFormat(format))
+			})
+		},
+	}
+	cmd.Flags().String("format", "table", "output format")
+	return cmd
+}
+
+func QuarantineCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quarantine",
+		Short: "show rows which could not be imported in lenient mode",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := cmd.Flags().GetString("format")
+			if err != nil {
+				return err
+			}
+			batchID, err := cmd.Flags().GetInt("batch")
+			if err != nil {
+				return err
+			}
+
+			w := os.Stdout
+			db, err := cmdutil.OpenDB(cmd)
+			if err != nil {
+				return err
+			}
+			ctx, _, err := cmdutil.PortfolioContext(cmd, db)
+			if err != nil {
+				return err
+			}
+			return WithTx(ctx, db, func(tx *sql.Tx) error {
+				return querycmd.QueryQuarantinedRows(ctx, w, tx, batchID, querycmd.OutputFormat(format))
+			})
+		},
+	}
+	cmd.Flags().String("format", "table", "output format")
+	cmd.Flags().Int("batch", 0, "batch id (all batches if 0)")
 	return cmd
 }
 
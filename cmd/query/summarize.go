@@ -21,7 +21,6 @@
 package main
 
 import (
-	"context"
 	"fmt"
 	"os"
 
@@ -30,6 +29,7 @@ import (
 
 	"github.com/eupholio/eupholio/models"
 	"github.com/eupholio/eupholio/pkg/cmdutil"
+	"github.com/eupholio/eupholio/pkg/eupholio"
 )
 
 func SummarizeCmd() *cobra.Command {
@@ -48,14 +48,17 @@ func summarizeCurrencyCmd() *cobra.Command {
 		Short: "summarize currency",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			w := os.Stdout
-			ctx := context.Background()
 
-			db, err := cmdutil.OpenDB()
+			db, err := cmdutil.OpenDB(cmd)
+			if err != nil {
+				return err
+			}
+			ctx, _, err := cmdutil.PortfolioContext(cmd, db)
 			if err != nil {
 				return err
 			}
 
-			events, err := models.Events(qm.Select("currency"), qm.GroupBy("currency")).All(ctx, db)
+			events, err := models.Events(qm.Select("currency"), eupholio.InPortfolio(ctx), qm.GroupBy("currency")).All(ctx, db)
 			if err != nil {
 				return err
 			}
@@ -3,10 +3,12 @@ module github.com/eupholio/eupholio
 go 1.13
 
 require (
+	github.com/BurntSushi/toml v1.2.1
 	github.com/ericlagergren/decimal v0.0.0-20181231230500-73749d4874d5
 	github.com/friendsofgo/errors v0.9.2
 	github.com/go-sql-driver/mysql v1.5.0
 	github.com/golang/protobuf v1.3.2
+	github.com/mattn/go-sqlite3 v1.14.6
 	github.com/olekukonko/tablewriter v0.0.4
 	github.com/spf13/cobra v1.1.1
 	github.com/volatiletech/null v8.0.0+incompatible // indirect
@@ -14,4 +16,5 @@ require (
 	github.com/volatiletech/sqlboiler v3.7.1+incompatible
 	github.com/volatiletech/sqlboiler/v4 v4.4.0
 	github.com/volatiletech/strmangle v0.0.1
+	gopkg.in/yaml.v2 v2.4.0
 )
@@ -25,6 +25,7 @@ import (
 // Balance is an object representing the database table.
 type Balance struct {
 	ID                int           `boil:"id" json:"id" toml:"id" yaml:"id"`
+	PortfolioID       int           `boil:"portfolio_id" json:"portfolio_id" toml:"portfolio_id" yaml:"portfolio_id"`
 	Year              int           `boil:"year" json:"year" toml:"year" yaml:"year"`
 	Currency          string        `boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
 	BeginningQuantity types.Decimal `boil:"beginning_quantity" json:"beginning_quantity" toml:"beginning_quantity" yaml:"beginning_quantity"`
@@ -40,6 +41,7 @@ type Balance struct {
 
 var BalanceColumns = struct {
 	ID                string
+	PortfolioID       string
 	Year              string
 	Currency          string
 	BeginningQuantity string
@@ -50,6 +52,7 @@ var BalanceColumns = struct {
 	Profit            string
 }{
 	ID:                "id",
+	PortfolioID:       "portfolio_id",
 	Year:              "year",
 	Currency:          "currency",
 	BeginningQuantity: "beginning_quantity",
@@ -131,6 +134,7 @@ func (w whereHelpertypes_Decimal) GTE(x types.Decimal) qm.QueryMod {
 
 var BalanceWhere = struct {
 	ID                whereHelperint
+	PortfolioID       whereHelperint
 	Year              whereHelperint
 	Currency          whereHelperstring
 	BeginningQuantity whereHelpertypes_Decimal
@@ -141,6 +145,7 @@ var BalanceWhere = struct {
 	Profit            whereHelpertypes_Decimal
 }{
 	ID:                whereHelperint{field: "`balance`.`id`"},
+	PortfolioID:       whereHelperint{field: "`balance`.`portfolio_id`"},
 	Year:              whereHelperint{field: "`balance`.`year`"},
 	Currency:          whereHelperstring{field: "`balance`.`currency`"},
 	BeginningQuantity: whereHelpertypes_Decimal{field: "`balance`.`beginning_quantity`"},
@@ -168,9 +173,9 @@ func (*balanceR) NewStruct() *balanceR {
 type balanceL struct{}
 
 var (
-	balanceAllColumns            = []string{"id", "year", "currency", "beginning_quantity", "open_quantity", "close_quantity", "price", "quantity", "profit"}
+	balanceAllColumns            = []string{"id", "portfolio_id", "year", "currency", "beginning_quantity", "open_quantity", "close_quantity", "price", "quantity", "profit"}
 	balanceColumnsWithoutDefault = []string{"year", "currency", "beginning_quantity", "open_quantity", "close_quantity", "price", "quantity", "profit"}
-	balanceColumnsWithDefault    = []string{"id"}
+	balanceColumnsWithDefault    = []string{"id", "portfolio_id"}
 	balancePrimaryKeyColumns     = []string{"id"}
 )
 
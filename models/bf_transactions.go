@@ -39,6 +39,11 @@ type BFTransaction struct {
 	DealType          null.Int          `boil:"deal_type" json:"deal_type,omitempty" toml:"deal_type" yaml:"deal_type,omitempty"`
 	OrderID           string            `boil:"order_id" json:"order_id" toml:"order_id" yaml:"order_id"`
 	Remarks           null.String       `boil:"remarks" json:"remarks,omitempty" toml:"remarks" yaml:"remarks,omitempty"`
+	SourceID          null.String       `boil:"source_id" json:"source_id,omitempty" toml:"source_id" yaml:"source_id,omitempty"`
+	PortfolioID       int               `boil:"portfolio_id" json:"portfolio_id" toml:"portfolio_id" yaml:"portfolio_id"`
+	Account           string            `boil:"account" json:"account" toml:"account" yaml:"account"`
+	BatchID           null.Int          `boil:"batch_id" json:"batch_id,omitempty" toml:"batch_id" yaml:"batch_id,omitempty"`
+	RowKey            null.String       `boil:"row_key" json:"row_key,omitempty" toml:"row_key" yaml:"row_key,omitempty"`
 
 	R *bfTransactionR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L bfTransactionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -59,6 +64,11 @@ var BFTransactionColumns = struct {
 	DealType          string
 	OrderID           string
 	Remarks           string
+	SourceID          string
+	PortfolioID       string
+	Account           string
+	BatchID           string
+	RowKey            string
 }{
 	ID:                "id",
 	TRDate:            "tr_date",
@@ -74,6 +84,11 @@ var BFTransactionColumns = struct {
 	DealType:          "deal_type",
 	OrderID:           "order_id",
 	Remarks:           "remarks",
+	SourceID:          "source_id",
+	PortfolioID:       "portfolio_id",
+	Account:           "account",
+	BatchID:           "batch_id",
+	RowKey:            "row_key",
 }
 
 // Generated where
@@ -103,52 +118,6 @@ func (w whereHelpertypes_NullDecimal) GTE(x types.NullDecimal) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
 
-type whereHelpernull_String struct{ field string }
-
-func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
-type whereHelpernull_Int struct{ field string }
-
-func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Int) NEQ(x null.Int) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Int) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-func (w whereHelpernull_Int) LT(x null.Int) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Int) LTE(x null.Int) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Int) GT(x null.Int) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Int) GTE(x null.Int) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 var BFTransactionWhere = struct {
 	ID                whereHelperint
 	TRDate            whereHelpertime_Time
@@ -164,6 +133,11 @@ var BFTransactionWhere = struct {
 	DealType          whereHelpernull_Int
 	OrderID           whereHelperstring
 	Remarks           whereHelpernull_String
+	SourceID          whereHelpernull_String
+	PortfolioID       whereHelperint
+	Account           whereHelperstring
+	BatchID           whereHelpernull_Int
+	RowKey            whereHelpernull_String
 }{
 	ID:                whereHelperint{field: "`bf_transactions`.`id`"},
 	TRDate:            whereHelpertime_Time{field: "`bf_transactions`.`tr_date`"},
@@ -179,6 +153,11 @@ var BFTransactionWhere = struct {
 	DealType:          whereHelpernull_Int{field: "`bf_transactions`.`deal_type`"},
 	OrderID:           whereHelperstring{field: "`bf_transactions`.`order_id`"},
 	Remarks:           whereHelpernull_String{field: "`bf_transactions`.`remarks`"},
+	SourceID:          whereHelpernull_String{field: "`bf_transactions`.`source_id`"},
+	PortfolioID:       whereHelperint{field: "`bf_transactions`.`portfolio_id`"},
+	Account:           whereHelperstring{field: "`bf_transactions`.`account`"},
+	BatchID:           whereHelpernull_Int{field: "`bf_transactions`.`batch_id`"},
+	RowKey:            whereHelpernull_String{field: "`bf_transactions`.`row_key`"},
 }
 
 // BFTransactionRels is where relationship names are stored.
@@ -198,9 +177,9 @@ func (*bfTransactionR) NewStruct() *bfTransactionR {
 type bfTransactionL struct{}
 
 var (
-	bfTransactionAllColumns            = []string{"id", "tr_date", "currency", "tr_type", "tr_price", "currency1", "currency1_quantity", "fee", "currency1_jpy_rate", "currency2", "currency2_quantity", "deal_type", "order_id", "remarks"}
-	bfTransactionColumnsWithoutDefault = []string{"tr_date", "currency", "tr_type", "tr_price", "currency1", "currency1_quantity", "fee", "currency1_jpy_rate", "currency2", "currency2_quantity", "deal_type", "order_id", "remarks"}
-	bfTransactionColumnsWithDefault    = []string{"id"}
+	bfTransactionAllColumns            = []string{"id", "tr_date", "currency", "tr_type", "tr_price", "currency1", "currency1_quantity", "fee", "currency1_jpy_rate", "currency2", "currency2_quantity", "deal_type", "order_id", "remarks", "source_id", "portfolio_id", "account", "batch_id", "row_key"}
+	bfTransactionColumnsWithoutDefault = []string{"tr_date", "currency", "tr_type", "tr_price", "currency1", "currency1_quantity", "fee", "currency1_jpy_rate", "currency2", "currency2_quantity", "deal_type", "order_id", "remarks", "source_id", "account", "batch_id", "row_key"}
+	bfTransactionColumnsWithDefault    = []string{"id", "portfolio_id"}
 	bfTransactionPrimaryKeyColumns     = []string{"id"}
 )
 
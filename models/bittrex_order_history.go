@@ -43,6 +43,10 @@ type BittrexOrderHistory struct {
 	Closed            time.Time         `boil:"closed" json:"closed" toml:"closed" yaml:"closed"`
 	TimeInForceTypeID int               `boil:"time_in_force_type_id" json:"time_in_force_type_id" toml:"time_in_force_type_id" yaml:"time_in_force_type_id"`
 	TimeInForce       null.String       `boil:"time_in_force" json:"time_in_force,omitempty" toml:"time_in_force" yaml:"time_in_force,omitempty"`
+	PortfolioID       int               `boil:"portfolio_id" json:"portfolio_id" toml:"portfolio_id" yaml:"portfolio_id"`
+	Account           string            `boil:"account" json:"account" toml:"account" yaml:"account"`
+	BatchID           null.Int          `boil:"batch_id" json:"batch_id,omitempty" toml:"batch_id" yaml:"batch_id,omitempty"`
+	RowKey            null.String       `boil:"row_key" json:"row_key,omitempty" toml:"row_key" yaml:"row_key,omitempty"`
 
 	R *bittrexOrderHistoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L bittrexOrderHistoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -67,6 +71,10 @@ var BittrexOrderHistoryColumns = struct {
 	Closed            string
 	TimeInForceTypeID string
 	TimeInForce       string
+	PortfolioID       string
+	Account           string
+	BatchID           string
+	RowKey            string
 }{
 	ID:                "id",
 	UUID:              "uuid",
@@ -86,6 +94,10 @@ var BittrexOrderHistoryColumns = struct {
 	Closed:            "closed",
 	TimeInForceTypeID: "time_in_force_type_id",
 	TimeInForce:       "time_in_force",
+	PortfolioID:       "portfolio_id",
+	Account:           "account",
+	BatchID:           "batch_id",
+	RowKey:            "row_key",
 }
 
 // Generated where
@@ -118,6 +130,10 @@ var BittrexOrderHistoryWhere = struct {
 	Closed            whereHelpertime_Time
 	TimeInForceTypeID whereHelperint
 	TimeInForce       whereHelpernull_String
+	PortfolioID       whereHelperint
+	Account           whereHelperstring
+	BatchID           whereHelpernull_Int
+	RowKey            whereHelpernull_String
 }{
 	ID:                whereHelperint{field: "`bittrex_order_history`.`id`"},
 	UUID:              whereHelperstring{field: "`bittrex_order_history`.`uuid`"},
@@ -137,6 +153,10 @@ var BittrexOrderHistoryWhere = struct {
 	Closed:            whereHelpertime_Time{field: "`bittrex_order_history`.`closed`"},
 	TimeInForceTypeID: whereHelperint{field: "`bittrex_order_history`.`time_in_force_type_id`"},
 	TimeInForce:       whereHelpernull_String{field: "`bittrex_order_history`.`time_in_force`"},
+	PortfolioID:       whereHelperint{field: "`bittrex_order_history`.`portfolio_id`"},
+	Account:           whereHelperstring{field: "`bittrex_order_history`.`account`"},
+	BatchID:           whereHelpernull_Int{field: "`bittrex_order_history`.`batch_id`"},
+	RowKey:            whereHelpernull_String{field: "`bittrex_order_history`.`row_key`"},
 }
 
 // BittrexOrderHistoryRels is where relationship names are stored.
@@ -156,9 +176,9 @@ func (*bittrexOrderHistoryR) NewStruct() *bittrexOrderHistoryR {
 type bittrexOrderHistoryL struct{}
 
 var (
-	bittrexOrderHistoryAllColumns            = []string{"id", "uuid", "exchange", "timestamp", "order_type", "limit", "quantity", "quantity_remaining", "commission", "price", "price_per_unit", "is_conditional", "condition", "condition_target", "immediate_or_cancel", "closed", "time_in_force_type_id", "time_in_force"}
-	bittrexOrderHistoryColumnsWithoutDefault = []string{"uuid", "exchange", "timestamp", "order_type", "limit", "quantity", "quantity_remaining", "commission", "price", "price_per_unit", "is_conditional", "condition", "condition_target", "immediate_or_cancel", "closed", "time_in_force_type_id", "time_in_force"}
-	bittrexOrderHistoryColumnsWithDefault    = []string{"id"}
+	bittrexOrderHistoryAllColumns            = []string{"id", "uuid", "exchange", "timestamp", "order_type", "limit", "quantity", "quantity_remaining", "commission", "price", "price_per_unit", "is_conditional", "condition", "condition_target", "immediate_or_cancel", "closed", "time_in_force_type_id", "time_in_force", "portfolio_id", "account", "batch_id", "row_key"}
+	bittrexOrderHistoryColumnsWithoutDefault = []string{"uuid", "exchange", "timestamp", "order_type", "limit", "quantity", "quantity_remaining", "commission", "price", "price_per_unit", "is_conditional", "condition", "condition_target", "immediate_or_cancel", "closed", "time_in_force_type_id", "time_in_force", "account", "batch_id", "row_key"}
+	bittrexOrderHistoryColumnsWithDefault    = []string{"id", "portfolio_id"}
 	bittrexOrderHistoryPrimaryKeyColumns     = []string{"id"}
 )
 
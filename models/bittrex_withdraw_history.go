@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -24,44 +25,64 @@ import (
 
 // BittrexWithdrawHistory is an object representing the database table.
 type BittrexWithdrawHistory struct {
-	ID        int           `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Timestamp time.Time     `boil:"timestamp" json:"timestamp" toml:"timestamp" yaml:"timestamp"`
-	Currency  string        `boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
-	Quantity  types.Decimal `boil:"quantity" json:"quantity" toml:"quantity" yaml:"quantity"`
-	Status    string        `boil:"status" json:"status" toml:"status" yaml:"status"`
+	ID          int           `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Timestamp   time.Time     `boil:"timestamp" json:"timestamp" toml:"timestamp" yaml:"timestamp"`
+	Currency    string        `boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
+	Quantity    types.Decimal `boil:"quantity" json:"quantity" toml:"quantity" yaml:"quantity"`
+	Status      string        `boil:"status" json:"status" toml:"status" yaml:"status"`
+	PortfolioID int           `boil:"portfolio_id" json:"portfolio_id" toml:"portfolio_id" yaml:"portfolio_id"`
+	Account     string        `boil:"account" json:"account" toml:"account" yaml:"account"`
+	BatchID     null.Int      `boil:"batch_id" json:"batch_id,omitempty" toml:"batch_id" yaml:"batch_id,omitempty"`
+	RowKey      null.String   `boil:"row_key" json:"row_key,omitempty" toml:"row_key" yaml:"row_key,omitempty"`
 
 	R *bittrexWithdrawHistoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L bittrexWithdrawHistoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var BittrexWithdrawHistoryColumns = struct {
-	ID        string
-	Timestamp string
-	Currency  string
-	Quantity  string
-	Status    string
+	ID          string
+	Timestamp   string
+	Currency    string
+	Quantity    string
+	Status      string
+	PortfolioID string
+	Account     string
+	BatchID     string
+	RowKey      string
 }{
-	ID:        "id",
-	Timestamp: "timestamp",
-	Currency:  "currency",
-	Quantity:  "quantity",
-	Status:    "status",
+	ID:          "id",
+	Timestamp:   "timestamp",
+	Currency:    "currency",
+	Quantity:    "quantity",
+	Status:      "status",
+	PortfolioID: "portfolio_id",
+	Account:     "account",
+	BatchID:     "batch_id",
+	RowKey:      "row_key",
 }
 
 // Generated where
 
 var BittrexWithdrawHistoryWhere = struct {
-	ID        whereHelperint
-	Timestamp whereHelpertime_Time
-	Currency  whereHelperstring
-	Quantity  whereHelpertypes_Decimal
-	Status    whereHelperstring
+	ID          whereHelperint
+	Timestamp   whereHelpertime_Time
+	Currency    whereHelperstring
+	Quantity    whereHelpertypes_Decimal
+	Status      whereHelperstring
+	PortfolioID whereHelperint
+	Account     whereHelperstring
+	BatchID     whereHelpernull_Int
+	RowKey      whereHelpernull_String
 }{
-	ID:        whereHelperint{field: "`bittrex_withdraw_history`.`id`"},
-	Timestamp: whereHelpertime_Time{field: "`bittrex_withdraw_history`.`timestamp`"},
-	Currency:  whereHelperstring{field: "`bittrex_withdraw_history`.`currency`"},
-	Quantity:  whereHelpertypes_Decimal{field: "`bittrex_withdraw_history`.`quantity`"},
-	Status:    whereHelperstring{field: "`bittrex_withdraw_history`.`status`"},
+	ID:          whereHelperint{field: "`bittrex_withdraw_history`.`id`"},
+	Timestamp:   whereHelpertime_Time{field: "`bittrex_withdraw_history`.`timestamp`"},
+	Currency:    whereHelperstring{field: "`bittrex_withdraw_history`.`currency`"},
+	Quantity:    whereHelpertypes_Decimal{field: "`bittrex_withdraw_history`.`quantity`"},
+	Status:      whereHelperstring{field: "`bittrex_withdraw_history`.`status`"},
+	PortfolioID: whereHelperint{field: "`bittrex_withdraw_history`.`portfolio_id`"},
+	Account:     whereHelperstring{field: "`bittrex_withdraw_history`.`account`"},
+	BatchID:     whereHelpernull_Int{field: "`bittrex_withdraw_history`.`batch_id`"},
+	RowKey:      whereHelpernull_String{field: "`bittrex_withdraw_history`.`row_key`"},
 }
 
 // BittrexWithdrawHistoryRels is where relationship names are stored.
@@ -81,9 +102,9 @@ func (*bittrexWithdrawHistoryR) NewStruct() *bittrexWithdrawHistoryR {
 type bittrexWithdrawHistoryL struct{}
 
 var (
-	bittrexWithdrawHistoryAllColumns            = []string{"id", "timestamp", "currency", "quantity", "status"}
-	bittrexWithdrawHistoryColumnsWithoutDefault = []string{"timestamp", "currency", "quantity", "status"}
-	bittrexWithdrawHistoryColumnsWithDefault    = []string{"id"}
+	bittrexWithdrawHistoryAllColumns            = []string{"id", "timestamp", "currency", "quantity", "status", "portfolio_id", "account", "batch_id", "row_key"}
+	bittrexWithdrawHistoryColumnsWithoutDefault = []string{"timestamp", "currency", "quantity", "status", "account", "batch_id", "row_key"}
+	bittrexWithdrawHistoryColumnsWithDefault    = []string{"id", "portfolio_id"}
 	bittrexWithdrawHistoryPrimaryKeyColumns     = []string{"id"}
 )
 
@@ -5,44 +5,70 @@ package models
 
 var TableNames = struct {
 	Balance                string
+	BFCollaterals          string
 	BFOrders               string
 	BFTransactions         string
 	BittrexDepositHistory  string
 	BittrexOrderHistory    string
 	BittrexWithdrawHistory string
+	CalculationYears       string
 	CoincheckHistory       string
+	CointrackingTrades     string
 	Config                 string
 	CryptactCustom         string
 	Entry                  string
 	Event                  string
+	ImportBatches          string
+	KoinlyTransactions     string
+	LedgerEntries          string
 	MarketPrice            string
+	NormalizedEvents       string
 	Method                 string
+	PipelineStages         string
+	PoloniexBorrowings     string
 	PoloniexDeposits       string
 	PoloniexDistributions  string
+	PoloniexLendings       string
 	PoloniexTrades         string
 	PoloniexWithdrawals    string
+	Portfolios             string
+	QuarantinedRows        string
 	Symbols                string
 	Transactions           string
 	Transition             string
+	TranslationWatermarks  string
 }{
 	Balance:                "balance",
+	BFCollaterals:          "bf_collaterals",
 	BFOrders:               "bf_orders",
 	BFTransactions:         "bf_transactions",
 	BittrexDepositHistory:  "bittrex_deposit_history",
 	BittrexOrderHistory:    "bittrex_order_history",
 	BittrexWithdrawHistory: "bittrex_withdraw_history",
+	CalculationYears:       "calculation_years",
 	CoincheckHistory:       "coincheck_history",
+	CointrackingTrades:     "cointracking_trades",
 	Config:                 "config",
 	CryptactCustom:         "cryptact_custom",
 	Entry:                  "entry",
 	Event:                  "event",
+	ImportBatches:          "import_batches",
+	KoinlyTransactions:     "koinly_transactions",
+	LedgerEntries:          "ledger_entries",
 	MarketPrice:            "market_price",
+	NormalizedEvents:       "normalized_events",
 	Method:                 "method",
+	PipelineStages:         "pipeline_stages",
+	PoloniexBorrowings:     "poloniex_borrowings",
 	PoloniexDeposits:       "poloniex_deposits",
 	PoloniexDistributions:  "poloniex_distributions",
+	PoloniexLendings:       "poloniex_lendings",
 	PoloniexTrades:         "poloniex_trades",
 	PoloniexWithdrawals:    "poloniex_withdrawals",
+	Portfolios:             "portfolios",
+	QuarantinedRows:        "quarantined_rows",
 	Symbols:                "symbols",
 	Transactions:           "transactions",
 	Transition:             "transition",
+	TranslationWatermarks:  "translation_watermarks",
 }
@@ -35,6 +35,11 @@ type CoincheckHistory struct {
 	OriginalCurrency null.String       `boil:"original_currency" json:"original_currency,omitempty" toml:"original_currency" yaml:"original_currency,omitempty"`
 	Fee              types.NullDecimal `boil:"fee" json:"fee,omitempty" toml:"fee" yaml:"fee,omitempty"`
 	Comment          string            `boil:"comment" json:"comment" toml:"comment" yaml:"comment"`
+	Pair             null.String       `boil:"pair" json:"pair,omitempty" toml:"pair" yaml:"pair,omitempty"`
+	PortfolioID      int               `boil:"portfolio_id" json:"portfolio_id" toml:"portfolio_id" yaml:"portfolio_id"`
+	Account          string            `boil:"account" json:"account" toml:"account" yaml:"account"`
+	BatchID          null.Int          `boil:"batch_id" json:"batch_id,omitempty" toml:"batch_id" yaml:"batch_id,omitempty"`
+	RowKey           null.String       `boil:"row_key" json:"row_key,omitempty" toml:"row_key" yaml:"row_key,omitempty"`
 
 	R *coincheckHistoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L coincheckHistoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -51,6 +56,11 @@ var CoincheckHistoryColumns = struct {
 	OriginalCurrency string
 	Fee              string
 	Comment          string
+	Pair             string
+	PortfolioID      string
+	Account          string
+	BatchID          string
+	RowKey           string
 }{
 	ID:               "id",
 	IDCode:           "id_code",
@@ -62,6 +72,11 @@ var CoincheckHistoryColumns = struct {
 	OriginalCurrency: "original_currency",
 	Fee:              "fee",
 	Comment:          "comment",
+	Pair:             "pair",
+	PortfolioID:      "portfolio_id",
+	Account:          "account",
+	BatchID:          "batch_id",
+	RowKey:           "row_key",
 }
 
 // Generated where
@@ -77,6 +92,11 @@ var CoincheckHistoryWhere = struct {
 	OriginalCurrency whereHelpernull_String
 	Fee              whereHelpertypes_NullDecimal
 	Comment          whereHelperstring
+	Pair             whereHelpernull_String
+	PortfolioID      whereHelperint
+	Account          whereHelperstring
+	BatchID          whereHelpernull_Int
+	RowKey           whereHelpernull_String
 }{
 	ID:               whereHelperint{field: "`coincheck_history`.`id`"},
 	IDCode:           whereHelperstring{field: "`coincheck_history`.`id_code`"},
@@ -88,6 +108,11 @@ var CoincheckHistoryWhere = struct {
 	OriginalCurrency: whereHelpernull_String{field: "`coincheck_history`.`original_currency`"},
 	Fee:              whereHelpertypes_NullDecimal{field: "`coincheck_history`.`fee`"},
 	Comment:          whereHelperstring{field: "`coincheck_history`.`comment`"},
+	Pair:             whereHelpernull_String{field: "`coincheck_history`.`pair`"},
+	PortfolioID:      whereHelperint{field: "`coincheck_history`.`portfolio_id`"},
+	Account:          whereHelperstring{field: "`coincheck_history`.`account`"},
+	BatchID:          whereHelpernull_Int{field: "`coincheck_history`.`batch_id`"},
+	RowKey:           whereHelpernull_String{field: "`coincheck_history`.`row_key`"},
 }
 
 // CoincheckHistoryRels is where relationship names are stored.
@@ -107,9 +132,9 @@ func (*coincheckHistoryR) NewStruct() *coincheckHistoryR {
 type coincheckHistoryL struct{}
 
 var (
-	coincheckHistoryAllColumns            = []string{"id", "id_code", "time", "operation", "amount", "trading_currency", "price", "original_currency", "fee", "comment"}
-	coincheckHistoryColumnsWithoutDefault = []string{"id_code", "time", "operation", "amount", "trading_currency", "price", "original_currency", "fee", "comment"}
-	coincheckHistoryColumnsWithDefault    = []string{"id"}
+	coincheckHistoryAllColumns            = []string{"id", "id_code", "time", "operation", "amount", "trading_currency", "price", "original_currency", "fee", "comment", "pair", "portfolio_id", "account", "batch_id", "row_key"}
+	coincheckHistoryColumnsWithoutDefault = []string{"id_code", "time", "operation", "amount", "trading_currency", "price", "original_currency", "fee", "comment", "pair", "account", "batch_id", "row_key"}
+	coincheckHistoryColumnsWithDefault    = []string{"id", "portfolio_id"}
 	coincheckHistoryPrimaryKeyColumns     = []string{"id"}
 )
 
@@ -23,34 +23,39 @@ import (
 
 // Config is an object representing the database table.
 type Config struct {
-	ID         int    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Year       int    `boil:"year" json:"year" toml:"year" yaml:"year"`
-	CostMethod string `boil:"cost_method" json:"cost_method" toml:"cost_method" yaml:"cost_method"`
+	ID          int    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	PortfolioID int    `boil:"portfolio_id" json:"portfolio_id" toml:"portfolio_id" yaml:"portfolio_id"`
+	Year        int    `boil:"year" json:"year" toml:"year" yaml:"year"`
+	CostMethod  string `boil:"cost_method" json:"cost_method" toml:"cost_method" yaml:"cost_method"`
 
 	R *configR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L configL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ConfigColumns = struct {
-	ID         string
-	Year       string
-	CostMethod string
+	ID          string
+	PortfolioID string
+	Year        string
+	CostMethod  string
 }{
-	ID:         "id",
-	Year:       "year",
-	CostMethod: "cost_method",
+	ID:          "id",
+	PortfolioID: "portfolio_id",
+	Year:        "year",
+	CostMethod:  "cost_method",
 }
 
 // Generated where
 
 var ConfigWhere = struct {
-	ID         whereHelperint
-	Year       whereHelperint
-	CostMethod whereHelperstring
+	ID          whereHelperint
+	PortfolioID whereHelperint
+	Year        whereHelperint
+	CostMethod  whereHelperstring
 }{
-	ID:         whereHelperint{field: "`config`.`id`"},
-	Year:       whereHelperint{field: "`config`.`year`"},
-	CostMethod: whereHelperstring{field: "`config`.`cost_method`"},
+	ID:          whereHelperint{field: "`config`.`id`"},
+	PortfolioID: whereHelperint{field: "`config`.`portfolio_id`"},
+	Year:        whereHelperint{field: "`config`.`year`"},
+	CostMethod:  whereHelperstring{field: "`config`.`cost_method`"},
 }
 
 // ConfigRels is where relationship names are stored.
@@ -70,10 +75,10 @@ func (*configR) NewStruct() *configR {
 type configL struct{}
 
 var (
-	configAllColumns            = []string{"id", "year", "cost_method"}
-	configColumnsWithoutDefault = []string{"id", "year", "cost_method"}
-	configColumnsWithDefault    = []string{}
-	configPrimaryKeyColumns     = []string{"id", "year"}
+	configAllColumns            = []string{"id", "portfolio_id", "year", "cost_method"}
+	configColumnsWithoutDefault = []string{"year", "cost_method"}
+	configColumnsWithDefault    = []string{"id", "portfolio_id"}
+	configPrimaryKeyColumns     = []string{"portfolio_id", "year"}
 )
 
 type (
@@ -359,7 +364,7 @@ func Configs(mods ...qm.QueryMod) configQuery {
 
 // FindConfig retrieves a single record by ID with an executor.
 // If selectCols is empty Find will return all columns.
-func FindConfig(ctx context.Context, exec boil.ContextExecutor, iD int, year int, selectCols ...string) (*Config, error) {
+func FindConfig(ctx context.Context, exec boil.ContextExecutor, portfolioID int, year int, selectCols ...string) (*Config, error) {
 	configObj := &Config{}
 
 	sel := "*"
@@ -367,10 +372,10 @@ func FindConfig(ctx context.Context, exec boil.ContextExecutor, iD int, year int
 		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
 	}
 	query := fmt.Sprintf(
-		"select %s from `config` where `id`=? AND `year`=?", sel,
+		"select %s from `config` where `portfolio_id`=? AND `year`=?", sel,
 	)
 
-	q := queries.Raw(query, iD, year)
+	q := queries.Raw(query, portfolioID, year)
 
 	err := q.Bind(ctx, exec, configObj)
 	if err != nil {
@@ -455,7 +460,7 @@ func (o *Config) Insert(ctx context.Context, exec boil.ContextExecutor, columns
 	}
 
 	identifierCols = []interface{}{
-		o.ID,
+		o.PortfolioID,
 		o.Year,
 	}
 
@@ -754,7 +759,7 @@ func (o *Config) Delete(ctx context.Context, exec boil.ContextExecutor) (int64,
 	}
 
 	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), configPrimaryKeyMapping)
-	sql := "DELETE FROM `config` WHERE `id`=? AND `year`=?"
+	sql := "DELETE FROM `config` WHERE `portfolio_id`=? AND `year`=?"
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -851,7 +856,7 @@ func (o ConfigSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (
 // Reload refetches the object from the database
 // using the primary keys with an executor.
 func (o *Config) Reload(ctx context.Context, exec boil.ContextExecutor) error {
-	ret, err := FindConfig(ctx, exec, o.ID, o.Year)
+	ret, err := FindConfig(ctx, exec, o.PortfolioID, o.Year)
 	if err != nil {
 		return err
 	}
@@ -890,16 +895,16 @@ func (o *ConfigSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor)
 }
 
 // ConfigExists checks if the Config row exists.
-func ConfigExists(ctx context.Context, exec boil.ContextExecutor, iD int, year int) (bool, error) {
+func ConfigExists(ctx context.Context, exec boil.ContextExecutor, portfolioID int, year int) (bool, error) {
 	var exists bool
-	sql := "select exists(select 1 from `config` where `id`=? AND `year`=? limit 1)"
+	sql := "select exists(select 1 from `config` where `portfolio_id`=? AND `year`=? limit 1)"
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, sql)
-		fmt.Fprintln(writer, iD, year)
+		fmt.Fprintln(writer, portfolioID, year)
 	}
-	row := exec.QueryRowContext(ctx, sql, iD, year)
+	row := exec.QueryRowContext(ctx, sql, portfolioID, year)
 
 	err := row.Scan(&exists)
 	if err != nil {
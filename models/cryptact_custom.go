@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -24,69 +25,89 @@ import (
 
 // CryptactCustom is an object representing the database table.
 type CryptactCustom struct {
-	ID        int               `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Timestamp time.Time         `boil:"timestamp" json:"timestamp" toml:"timestamp" yaml:"timestamp"`
-	Action    string            `boil:"action" json:"action" toml:"action" yaml:"action"`
-	Source    string            `boil:"source" json:"source" toml:"source" yaml:"source"`
-	Base      string            `boil:"base" json:"base" toml:"base" yaml:"base"`
-	Volume    types.Decimal     `boil:"volume" json:"volume" toml:"volume" yaml:"volume"`
-	Price     types.NullDecimal `boil:"price" json:"price,omitempty" toml:"price" yaml:"price,omitempty"`
-	Counter   string            `boil:"counter" json:"counter" toml:"counter" yaml:"counter"`
-	Fee       types.Decimal     `boil:"fee" json:"fee" toml:"fee" yaml:"fee"`
-	FeeCcy    string            `boil:"fee_ccy" json:"fee_ccy" toml:"fee_ccy" yaml:"fee_ccy"`
+	ID          int               `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Timestamp   time.Time         `boil:"timestamp" json:"timestamp" toml:"timestamp" yaml:"timestamp"`
+	Action      string            `boil:"action" json:"action" toml:"action" yaml:"action"`
+	Source      string            `boil:"source" json:"source" toml:"source" yaml:"source"`
+	Base        string            `boil:"base" json:"base" toml:"base" yaml:"base"`
+	Volume      types.Decimal     `boil:"volume" json:"volume" toml:"volume" yaml:"volume"`
+	Price       types.NullDecimal `boil:"price" json:"price,omitempty" toml:"price" yaml:"price,omitempty"`
+	Counter     string            `boil:"counter" json:"counter" toml:"counter" yaml:"counter"`
+	Fee         types.Decimal     `boil:"fee" json:"fee" toml:"fee" yaml:"fee"`
+	FeeCcy      string            `boil:"fee_ccy" json:"fee_ccy" toml:"fee_ccy" yaml:"fee_ccy"`
+	PortfolioID int               `boil:"portfolio_id" json:"portfolio_id" toml:"portfolio_id" yaml:"portfolio_id"`
+	Account     string            `boil:"account" json:"account" toml:"account" yaml:"account"`
+	BatchID     null.Int          `boil:"batch_id" json:"batch_id,omitempty" toml:"batch_id" yaml:"batch_id,omitempty"`
+	RowKey      null.String       `boil:"row_key" json:"row_key,omitempty" toml:"row_key" yaml:"row_key,omitempty"`
 
 	R *cryptactCustomR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L cryptactCustomL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var CryptactCustomColumns = struct {
-	ID        string
-	Timestamp string
-	Action    string
-	Source    string
-	Base      string
-	Volume    string
-	Price     string
-	Counter   string
-	Fee       string
-	FeeCcy    string
+	ID          string
+	Timestamp   string
+	Action      string
+	Source      string
+	Base        string
+	Volume      string
+	Price       string
+	Counter     string
+	Fee         string
+	FeeCcy      string
+	PortfolioID string
+	Account     string
+	BatchID     string
+	RowKey      string
 }{
-	ID:        "id",
-	Timestamp: "timestamp",
-	Action:    "action",
-	Source:    "source",
-	Base:      "base",
-	Volume:    "volume",
-	Price:     "price",
-	Counter:   "counter",
-	Fee:       "fee",
-	FeeCcy:    "fee_ccy",
+	ID:          "id",
+	Timestamp:   "timestamp",
+	Action:      "action",
+	Source:      "source",
+	Base:        "base",
+	Volume:      "volume",
+	Price:       "price",
+	Counter:     "counter",
+	Fee:         "fee",
+	FeeCcy:      "fee_ccy",
+	PortfolioID: "portfolio_id",
+	Account:     "account",
+	BatchID:     "batch_id",
+	RowKey:      "row_key",
 }
 
 // Generated where
 
 var CryptactCustomWhere = struct {
-	ID        whereHelperint
-	Timestamp whereHelpertime_Time
-	Action    whereHelperstring
-	Source    whereHelperstring
-	Base      whereHelperstring
-	Volume    whereHelpertypes_Decimal
-	Price     whereHelpertypes_NullDecimal
-	Counter   whereHelperstring
-	Fee       whereHelpertypes_Decimal
-	FeeCcy    whereHelperstring
+	ID          whereHelperint
+	Timestamp   whereHelpertime_Time
+	Action      whereHelperstring
+	Source      whereHelperstring
+	Base        whereHelperstring
+	Volume      whereHelpertypes_Decimal
+	Price       whereHelpertypes_NullDecimal
+	Counter     whereHelperstring
+	Fee         whereHelpertypes_Decimal
+	FeeCcy      whereHelperstring
+	PortfolioID whereHelperint
+	Account     whereHelperstring
+	BatchID     whereHelpernull_Int
+	RowKey      whereHelpernull_String
 }{
-	ID:        whereHelperint{field: "`cryptact_custom`.`id`"},
-	Timestamp: whereHelpertime_Time{field: "`cryptact_custom`.`timestamp`"},
-	Action:    whereHelperstring{field: "`cryptact_custom`.`action`"},
-	Source:    whereHelperstring{field: "`cryptact_custom`.`source`"},
-	Base:      whereHelperstring{field: "`cryptact_custom`.`base`"},
-	Volume:    whereHelpertypes_Decimal{field: "`cryptact_custom`.`volume`"},
-	Price:     whereHelpertypes_NullDecimal{field: "`cryptact_custom`.`price`"},
-	Counter:   whereHelperstring{field: "`cryptact_custom`.`counter`"},
-	Fee:       whereHelpertypes_Decimal{field: "`cryptact_custom`.`fee`"},
-	FeeCcy:    whereHelperstring{field: "`cryptact_custom`.`fee_ccy`"},
+	ID:          whereHelperint{field: "`cryptact_custom`.`id`"},
+	Timestamp:   whereHelpertime_Time{field: "`cryptact_custom`.`timestamp`"},
+	Action:      whereHelperstring{field: "`cryptact_custom`.`action`"},
+	Source:      whereHelperstring{field: "`cryptact_custom`.`source`"},
+	Base:        whereHelperstring{field: "`cryptact_custom`.`base`"},
+	Volume:      whereHelpertypes_Decimal{field: "`cryptact_custom`.`volume`"},
+	Price:       whereHelpertypes_NullDecimal{field: "`cryptact_custom`.`price`"},
+	Counter:     whereHelperstring{field: "`cryptact_custom`.`counter`"},
+	Fee:         whereHelpertypes_Decimal{field: "`cryptact_custom`.`fee`"},
+	FeeCcy:      whereHelperstring{field: "`cryptact_custom`.`fee_ccy`"},
+	PortfolioID: whereHelperint{field: "`cryptact_custom`.`portfolio_id`"},
+	Account:     whereHelperstring{field: "`cryptact_custom`.`account`"},
+	BatchID:     whereHelpernull_Int{field: "`cryptact_custom`.`batch_id`"},
+	RowKey:      whereHelpernull_String{field: "`cryptact_custom`.`row_key`"},
 }
 
 // CryptactCustomRels is where relationship names are stored.
@@ -106,9 +127,9 @@ func (*cryptactCustomR) NewStruct() *cryptactCustomR {
 type cryptactCustomL struct{}
 
 var (
-	cryptactCustomAllColumns            = []string{"id", "timestamp", "action", "source", "base", "volume", "price", "counter", "fee", "fee_ccy"}
-	cryptactCustomColumnsWithoutDefault = []string{"timestamp", "action", "source", "base", "volume", "price", "counter", "fee", "fee_ccy"}
-	cryptactCustomColumnsWithDefault    = []string{"id"}
+	cryptactCustomAllColumns            = []string{"id", "timestamp", "action", "source", "base", "volume", "price", "counter", "fee", "fee_ccy", "portfolio_id", "account", "batch_id", "row_key"}
+	cryptactCustomColumnsWithoutDefault = []string{"timestamp", "action", "source", "base", "volume", "price", "counter", "fee", "fee_ccy", "account", "batch_id", "row_key"}
+	cryptactCustomColumnsWithDefault    = []string{"id", "portfolio_id"}
 	cryptactCustomPrimaryKeyColumns     = []string{"id"}
 )
 
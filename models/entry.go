@@ -25,6 +25,7 @@ import (
 // Entry is an object representing the database table.
 type Entry struct {
 	ID            int               `boil:"id" json:"id" toml:"id" yaml:"id"`
+	PortfolioID   int               `boil:"portfolio_id" json:"portfolio_id" toml:"portfolio_id" yaml:"portfolio_id"`
 	TransactionID int               `boil:"transaction_id" json:"transaction_id" toml:"transaction_id" yaml:"transaction_id"`
 	Time          time.Time         `boil:"time" json:"time" toml:"time" yaml:"time"`
 	Type          string            `boil:"type" json:"type" toml:"type" yaml:"type"`
@@ -42,6 +43,7 @@ type Entry struct {
 
 var EntryColumns = struct {
 	ID            string
+	PortfolioID   string
 	TransactionID string
 	Time          string
 	Type          string
@@ -54,6 +56,7 @@ var EntryColumns = struct {
 	Price         string
 }{
 	ID:            "id",
+	PortfolioID:   "portfolio_id",
 	TransactionID: "transaction_id",
 	Time:          "time",
 	Type:          "type",
@@ -70,6 +73,7 @@ var EntryColumns = struct {
 
 var EntryWhere = struct {
 	ID            whereHelperint
+	PortfolioID   whereHelperint
 	TransactionID whereHelperint
 	Time          whereHelpertime_Time
 	Type          whereHelperstring
@@ -82,6 +86,7 @@ var EntryWhere = struct {
 	Price         whereHelpertypes_NullDecimal
 }{
 	ID:            whereHelperint{field: "`entry`.`id`"},
+	PortfolioID:   whereHelperint{field: "`entry`.`portfolio_id`"},
 	TransactionID: whereHelperint{field: "`entry`.`transaction_id`"},
 	Time:          whereHelpertime_Time{field: "`entry`.`time`"},
 	Type:          whereHelperstring{field: "`entry`.`type`"},
@@ -111,9 +116,9 @@ func (*entryR) NewStruct() *entryR {
 type entryL struct{}
 
 var (
-	entryAllColumns            = []string{"id", "transaction_id", "time", "type", "currency", "quantity", "position", "fiat_currency", "fiat_quantity", "commission", "price"}
+	entryAllColumns            = []string{"id", "portfolio_id", "transaction_id", "time", "type", "currency", "quantity", "position", "fiat_currency", "fiat_quantity", "commission", "price"}
 	entryColumnsWithoutDefault = []string{"id", "transaction_id", "time", "type", "currency", "quantity", "position", "fiat_currency", "fiat_quantity", "commission", "price"}
-	entryColumnsWithDefault    = []string{}
+	entryColumnsWithDefault    = []string{"portfolio_id"}
 	entryPrimaryKeyColumns     = []string{"id"}
 )
 
@@ -25,6 +25,7 @@ import (
 // Event is an object representing the database table.
 type Event struct {
 	ID            int           `boil:"id" json:"id" toml:"id" yaml:"id"`
+	PortfolioID   int           `boil:"portfolio_id" json:"portfolio_id" toml:"portfolio_id" yaml:"portfolio_id"`
 	TransactionID int           `boil:"transaction_id" json:"transaction_id" toml:"transaction_id" yaml:"transaction_id"`
 	Time          time.Time     `boil:"time" json:"time" toml:"time" yaml:"time"`
 	Type          string        `boil:"type" json:"type" toml:"type" yaml:"type"`
@@ -39,6 +40,7 @@ type Event struct {
 
 var EventColumns = struct {
 	ID            string
+	PortfolioID   string
 	TransactionID string
 	Time          string
 	Type          string
@@ -48,6 +50,7 @@ var EventColumns = struct {
 	BaseQuantity  string
 }{
 	ID:            "id",
+	PortfolioID:   "portfolio_id",
 	TransactionID: "transaction_id",
 	Time:          "time",
 	Type:          "type",
@@ -61,6 +64,7 @@ var EventColumns = struct {
 
 var EventWhere = struct {
 	ID            whereHelperint
+	PortfolioID   whereHelperint
 	TransactionID whereHelperint
 	Time          whereHelpertime_Time
 	Type          whereHelperstring
@@ -70,6 +74,7 @@ var EventWhere = struct {
 	BaseQuantity  whereHelpertypes_Decimal
 }{
 	ID:            whereHelperint{field: "`event`.`id`"},
+	PortfolioID:   whereHelperint{field: "`event`.`portfolio_id`"},
 	TransactionID: whereHelperint{field: "`event`.`transaction_id`"},
 	Time:          whereHelpertime_Time{field: "`event`.`time`"},
 	Type:          whereHelperstring{field: "`event`.`type`"},
@@ -96,9 +101,9 @@ func (*eventR) NewStruct() *eventR {
 type eventL struct{}
 
 var (
-	eventAllColumns            = []string{"id", "transaction_id", "time", "type", "currency", "quantity", "base_currency", "base_quantity"}
+	eventAllColumns            = []string{"id", "portfolio_id", "transaction_id", "time", "type", "currency", "quantity", "base_currency", "base_quantity"}
 	eventColumnsWithoutDefault = []string{"transaction_id", "time", "type", "currency", "quantity", "base_currency", "base_quantity"}
-	eventColumnsWithDefault    = []string{"id"}
+	eventColumnsWithDefault    = []string{"id", "portfolio_id"}
 	eventPrimaryKeyColumns     = []string{"id"}
 )
 
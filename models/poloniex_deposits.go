@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -24,49 +25,69 @@ import (
 
 // PoloniexDeposit is an object representing the database table.
 type PoloniexDeposit struct {
-	ID       int           `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Date     time.Time     `boil:"date" json:"date" toml:"date" yaml:"date"`
-	Currency string        `boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
-	Amount   types.Decimal `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
-	Address  string        `boil:"address" json:"address" toml:"address" yaml:"address"`
-	Status   string        `boil:"status" json:"status" toml:"status" yaml:"status"`
+	ID          int           `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Date        time.Time     `boil:"date" json:"date" toml:"date" yaml:"date"`
+	Currency    string        `boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
+	Amount      types.Decimal `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	Address     string        `boil:"address" json:"address" toml:"address" yaml:"address"`
+	Status      string        `boil:"status" json:"status" toml:"status" yaml:"status"`
+	PortfolioID int           `boil:"portfolio_id" json:"portfolio_id" toml:"portfolio_id" yaml:"portfolio_id"`
+	Account     string        `boil:"account" json:"account" toml:"account" yaml:"account"`
+	BatchID     null.Int      `boil:"batch_id" json:"batch_id,omitempty" toml:"batch_id" yaml:"batch_id,omitempty"`
+	RowKey      null.String   `boil:"row_key" json:"row_key,omitempty" toml:"row_key" yaml:"row_key,omitempty"`
 
 	R *poloniexDepositR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L poloniexDepositL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var PoloniexDepositColumns = struct {
-	ID       string
-	Date     string
-	Currency string
-	Amount   string
-	Address  string
-	Status   string
+	ID          string
+	Date        string
+	Currency    string
+	Amount      string
+	Address     string
+	Status      string
+	PortfolioID string
+	Account     string
+	BatchID     string
+	RowKey      string
 }{
-	ID:       "id",
-	Date:     "date",
-	Currency: "currency",
-	Amount:   "amount",
-	Address:  "address",
-	Status:   "status",
+	ID:          "id",
+	Date:        "date",
+	Currency:    "currency",
+	Amount:      "amount",
+	Address:     "address",
+	Status:      "status",
+	PortfolioID: "portfolio_id",
+	Account:     "account",
+	BatchID:     "batch_id",
+	RowKey:      "row_key",
 }
 
 // Generated where
 
 var PoloniexDepositWhere = struct {
-	ID       whereHelperint
-	Date     whereHelpertime_Time
-	Currency whereHelperstring
-	Amount   whereHelpertypes_Decimal
-	Address  whereHelperstring
-	Status   whereHelperstring
+	ID          whereHelperint
+	Date        whereHelpertime_Time
+	Currency    whereHelperstring
+	Amount      whereHelpertypes_Decimal
+	Address     whereHelperstring
+	Status      whereHelperstring
+	PortfolioID whereHelperint
+	Account     whereHelperstring
+	BatchID     whereHelpernull_Int
+	RowKey      whereHelpernull_String
 }{
-	ID:       whereHelperint{field: "`poloniex_deposits`.`id`"},
-	Date:     whereHelpertime_Time{field: "`poloniex_deposits`.`date`"},
-	Currency: whereHelperstring{field: "`poloniex_deposits`.`currency`"},
-	Amount:   whereHelpertypes_Decimal{field: "`poloniex_deposits`.`amount`"},
-	Address:  whereHelperstring{field: "`poloniex_deposits`.`address`"},
-	Status:   whereHelperstring{field: "`poloniex_deposits`.`status`"},
+	ID:          whereHelperint{field: "`poloniex_deposits`.`id`"},
+	Date:        whereHelpertime_Time{field: "`poloniex_deposits`.`date`"},
+	Currency:    whereHelperstring{field: "`poloniex_deposits`.`currency`"},
+	Amount:      whereHelpertypes_Decimal{field: "`poloniex_deposits`.`amount`"},
+	Address:     whereHelperstring{field: "`poloniex_deposits`.`address`"},
+	Status:      whereHelperstring{field: "`poloniex_deposits`.`status`"},
+	PortfolioID: whereHelperint{field: "`poloniex_deposits`.`portfolio_id`"},
+	Account:     whereHelperstring{field: "`poloniex_deposits`.`account`"},
+	BatchID:     whereHelpernull_Int{field: "`poloniex_deposits`.`batch_id`"},
+	RowKey:      whereHelpernull_String{field: "`poloniex_deposits`.`row_key`"},
 }
 
 // PoloniexDepositRels is where relationship names are stored.
@@ -86,9 +107,9 @@ func (*poloniexDepositR) NewStruct() *poloniexDepositR {
 type poloniexDepositL struct{}
 
 var (
-	poloniexDepositAllColumns            = []string{"id", "date", "currency", "amount", "address", "status"}
-	poloniexDepositColumnsWithoutDefault = []string{"date", "currency", "amount", "address", "status"}
-	poloniexDepositColumnsWithDefault    = []string{"id"}
+	poloniexDepositAllColumns            = []string{"id", "date", "currency", "amount", "address", "status", "portfolio_id", "account", "batch_id", "row_key"}
+	poloniexDepositColumnsWithoutDefault = []string{"date", "currency", "amount", "address", "status", "account", "batch_id", "row_key"}
+	poloniexDepositColumnsWithDefault    = []string{"id", "portfolio_id"}
 	poloniexDepositPrimaryKeyColumns     = []string{"id"}
 )
 
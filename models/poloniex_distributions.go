@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -24,44 +25,64 @@ import (
 
 // PoloniexDistribution is an object representing the database table.
 type PoloniexDistribution struct {
-	ID       int           `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Date     time.Time     `boil:"date" json:"date" toml:"date" yaml:"date"`
-	Currency string        `boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
-	Amount   types.Decimal `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
-	Wallet   string        `boil:"wallet" json:"wallet" toml:"wallet" yaml:"wallet"`
+	ID          int           `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Date        time.Time     `boil:"date" json:"date" toml:"date" yaml:"date"`
+	Currency    string        `boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
+	Amount      types.Decimal `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	Wallet      string        `boil:"wallet" json:"wallet" toml:"wallet" yaml:"wallet"`
+	PortfolioID int           `boil:"portfolio_id" json:"portfolio_id" toml:"portfolio_id" yaml:"portfolio_id"`
+	Account     string        `boil:"account" json:"account" toml:"account" yaml:"account"`
+	BatchID     null.Int      `boil:"batch_id" json:"batch_id,omitempty" toml:"batch_id" yaml:"batch_id,omitempty"`
+	RowKey      null.String   `boil:"row_key" json:"row_key,omitempty" toml:"row_key" yaml:"row_key,omitempty"`
 
 	R *poloniexDistributionR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L poloniexDistributionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var PoloniexDistributionColumns = struct {
-	ID       string
-	Date     string
-	Currency string
-	Amount   string
-	Wallet   string
+	ID          string
+	Date        string
+	Currency    string
+	Amount      string
+	Wallet      string
+	PortfolioID string
+	Account     string
+	BatchID     string
+	RowKey      string
 }{
-	ID:       "id",
-	Date:     "date",
-	Currency: "currency",
-	Amount:   "amount",
-	Wallet:   "wallet",
+	ID:          "id",
+	Date:        "date",
+	Currency:    "currency",
+	Amount:      "amount",
+	Wallet:      "wallet",
+	PortfolioID: "portfolio_id",
+	Account:     "account",
+	BatchID:     "batch_id",
+	RowKey:      "row_key",
 }
 
 // Generated where
 
 var PoloniexDistributionWhere = struct {
-	ID       whereHelperint
-	Date     whereHelpertime_Time
-	Currency whereHelperstring
-	Amount   whereHelpertypes_Decimal
-	Wallet   whereHelperstring
+	ID          whereHelperint
+	Date        whereHelpertime_Time
+	Currency    whereHelperstring
+	Amount      whereHelpertypes_Decimal
+	Wallet      whereHelperstring
+	PortfolioID whereHelperint
+	Account     whereHelperstring
+	BatchID     whereHelpernull_Int
+	RowKey      whereHelpernull_String
 }{
-	ID:       whereHelperint{field: "`poloniex_distributions`.`id`"},
-	Date:     whereHelpertime_Time{field: "`poloniex_distributions`.`date`"},
-	Currency: whereHelperstring{field: "`poloniex_distributions`.`currency`"},
-	Amount:   whereHelpertypes_Decimal{field: "`poloniex_distributions`.`amount`"},
-	Wallet:   whereHelperstring{field: "`poloniex_distributions`.`wallet`"},
+	ID:          whereHelperint{field: "`poloniex_distributions`.`id`"},
+	Date:        whereHelpertime_Time{field: "`poloniex_distributions`.`date`"},
+	Currency:    whereHelperstring{field: "`poloniex_distributions`.`currency`"},
+	Amount:      whereHelpertypes_Decimal{field: "`poloniex_distributions`.`amount`"},
+	Wallet:      whereHelperstring{field: "`poloniex_distributions`.`wallet`"},
+	PortfolioID: whereHelperint{field: "`poloniex_distributions`.`portfolio_id`"},
+	Account:     whereHelperstring{field: "`poloniex_distributions`.`account`"},
+	BatchID:     whereHelpernull_Int{field: "`poloniex_distributions`.`batch_id`"},
+	RowKey:      whereHelpernull_String{field: "`poloniex_distributions`.`row_key`"},
 }
 
 // PoloniexDistributionRels is where relationship names are stored.
@@ -81,9 +102,9 @@ func (*poloniexDistributionR) NewStruct() *poloniexDistributionR {
 type poloniexDistributionL struct{}
 
 var (
-	poloniexDistributionAllColumns            = []string{"id", "date", "currency", "amount", "wallet"}
-	poloniexDistributionColumnsWithoutDefault = []string{"date", "currency", "amount", "wallet"}
-	poloniexDistributionColumnsWithDefault    = []string{"id"}
+	poloniexDistributionAllColumns            = []string{"id", "date", "currency", "amount", "wallet", "portfolio_id", "account", "batch_id", "row_key"}
+	poloniexDistributionColumnsWithoutDefault = []string{"date", "currency", "amount", "wallet", "account", "batch_id", "row_key"}
+	poloniexDistributionColumnsWithDefault    = []string{"id", "portfolio_id"}
 	poloniexDistributionPrimaryKeyColumns     = []string{"id"}
 )
 
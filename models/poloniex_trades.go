@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -27,6 +28,7 @@ type PoloniexTrade struct {
 	ID                int           `boil:"id" json:"id" toml:"id" yaml:"id"`
 	Date              time.Time     `boil:"date" json:"date" toml:"date" yaml:"date"`
 	Market            string        `boil:"market" json:"market" toml:"market" yaml:"market"`
+	Category          string        `boil:"category" json:"category" toml:"category" yaml:"category"`
 	Type              string        `boil:"type" json:"type" toml:"type" yaml:"type"`
 	Price             types.Decimal `boil:"price" json:"price" toml:"price" yaml:"price"`
 	Amount            types.Decimal `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
@@ -37,6 +39,10 @@ type PoloniexTrade struct {
 	QuoteTotalLessFee types.Decimal `boil:"quote_total_less_fee" json:"quote_total_less_fee" toml:"quote_total_less_fee" yaml:"quote_total_less_fee"`
 	FeeCurrency       string        `boil:"fee_currency" json:"fee_currency" toml:"fee_currency" yaml:"fee_currency"`
 	FeeTotal          types.Decimal `boil:"fee_total" json:"fee_total" toml:"fee_total" yaml:"fee_total"`
+	PortfolioID       int           `boil:"portfolio_id" json:"portfolio_id" toml:"portfolio_id" yaml:"portfolio_id"`
+	Account           string        `boil:"account" json:"account" toml:"account" yaml:"account"`
+	BatchID           null.Int      `boil:"batch_id" json:"batch_id,omitempty" toml:"batch_id" yaml:"batch_id,omitempty"`
+	RowKey            null.String   `boil:"row_key" json:"row_key,omitempty" toml:"row_key" yaml:"row_key,omitempty"`
 
 	R *poloniexTradeR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L poloniexTradeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -46,6 +52,7 @@ var PoloniexTradeColumns = struct {
 	ID                string
 	Date              string
 	Market            string
+	Category          string
 	Type              string
 	Price             string
 	Amount            string
@@ -56,10 +63,15 @@ var PoloniexTradeColumns = struct {
 	QuoteTotalLessFee string
 	FeeCurrency       string
 	FeeTotal          string
+	PortfolioID       string
+	Account           string
+	BatchID           string
+	RowKey            string
 }{
 	ID:                "id",
 	Date:              "date",
 	Market:            "market",
+	Category:          "category",
 	Type:              "type",
 	Price:             "price",
 	Amount:            "amount",
@@ -70,6 +82,10 @@ var PoloniexTradeColumns = struct {
 	QuoteTotalLessFee: "quote_total_less_fee",
 	FeeCurrency:       "fee_currency",
 	FeeTotal:          "fee_total",
+	PortfolioID:       "portfolio_id",
+	Account:           "account",
+	BatchID:           "batch_id",
+	RowKey:            "row_key",
 }
 
 // Generated where
@@ -101,6 +117,7 @@ var PoloniexTradeWhere = struct {
 	ID                whereHelperint
 	Date              whereHelpertime_Time
 	Market            whereHelperstring
+	Category          whereHelperstring
 	Type              whereHelperstring
 	Price             whereHelpertypes_Decimal
 	Amount            whereHelpertypes_Decimal
@@ -111,10 +128,15 @@ var PoloniexTradeWhere = struct {
 	QuoteTotalLessFee whereHelpertypes_Decimal
 	FeeCurrency       whereHelperstring
 	FeeTotal          whereHelpertypes_Decimal
+	PortfolioID       whereHelperint
+	Account           whereHelperstring
+	BatchID           whereHelpernull_Int
+	RowKey            whereHelpernull_String
 }{
 	ID:                whereHelperint{field: "`poloniex_trades`.`id`"},
 	Date:              whereHelpertime_Time{field: "`poloniex_trades`.`date`"},
 	Market:            whereHelperstring{field: "`poloniex_trades`.`market`"},
+	Category:          whereHelperstring{field: "`poloniex_trades`.`category`"},
 	Type:              whereHelperstring{field: "`poloniex_trades`.`type`"},
 	Price:             whereHelpertypes_Decimal{field: "`poloniex_trades`.`price`"},
 	Amount:            whereHelpertypes_Decimal{field: "`poloniex_trades`.`amount`"},
@@ -125,6 +147,10 @@ var PoloniexTradeWhere = struct {
 	QuoteTotalLessFee: whereHelpertypes_Decimal{field: "`poloniex_trades`.`quote_total_less_fee`"},
 	FeeCurrency:       whereHelperstring{field: "`poloniex_trades`.`fee_currency`"},
 	FeeTotal:          whereHelpertypes_Decimal{field: "`poloniex_trades`.`fee_total`"},
+	PortfolioID:       whereHelperint{field: "`poloniex_trades`.`portfolio_id`"},
+	Account:           whereHelperstring{field: "`poloniex_trades`.`account`"},
+	BatchID:           whereHelpernull_Int{field: "`poloniex_trades`.`batch_id`"},
+	RowKey:            whereHelpernull_String{field: "`poloniex_trades`.`row_key`"},
 }
 
 // PoloniexTradeRels is where relationship names are stored.
@@ -144,9 +170,9 @@ func (*poloniexTradeR) NewStruct() *poloniexTradeR {
 type poloniexTradeL struct{}
 
 var (
-	poloniexTradeAllColumns            = []string{"id", "date", "market", "type", "price", "amount", "total", "fee", "order_number", "base_total_less_fee", "quote_total_less_fee", "fee_currency", "fee_total"}
-	poloniexTradeColumnsWithoutDefault = []string{"date", "market", "type", "price", "amount", "total", "fee", "order_number", "base_total_less_fee", "quote_total_less_fee", "fee_currency", "fee_total"}
-	poloniexTradeColumnsWithDefault    = []string{"id"}
+	poloniexTradeAllColumns            = []string{"id", "date", "market", "category", "type", "price", "amount", "total", "fee", "order_number", "base_total_less_fee", "quote_total_less_fee", "fee_currency", "fee_total", "portfolio_id", "account", "batch_id", "row_key"}
+	poloniexTradeColumnsWithoutDefault = []string{"date", "market", "category", "type", "price", "amount", "total", "fee", "order_number", "base_total_less_fee", "quote_total_less_fee", "fee_currency", "fee_total", "account", "batch_id", "row_key"}
+	poloniexTradeColumnsWithDefault    = []string{"id", "portfolio_id"}
 	poloniexTradePrimaryKeyColumns     = []string{"id"}
 )
 
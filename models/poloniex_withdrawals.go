@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -32,6 +33,10 @@ type PoloniexWithdrawal struct {
 	AmountMinusFee types.Decimal `boil:"amount_minus_fee" json:"amount_minus_fee" toml:"amount_minus_fee" yaml:"amount_minus_fee"`
 	Address        string        `boil:"address" json:"address" toml:"address" yaml:"address"`
 	Status         string        `boil:"status" json:"status" toml:"status" yaml:"status"`
+	PortfolioID    int           `boil:"portfolio_id" json:"portfolio_id" toml:"portfolio_id" yaml:"portfolio_id"`
+	Account        string        `boil:"account" json:"account" toml:"account" yaml:"account"`
+	BatchID        null.Int      `boil:"batch_id" json:"batch_id,omitempty" toml:"batch_id" yaml:"batch_id,omitempty"`
+	RowKey         null.String   `boil:"row_key" json:"row_key,omitempty" toml:"row_key" yaml:"row_key,omitempty"`
 
 	R *poloniexWithdrawalR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L poloniexWithdrawalL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -46,6 +51,10 @@ var PoloniexWithdrawalColumns = struct {
 	AmountMinusFee string
 	Address        string
 	Status         string
+	PortfolioID    string
+	Account        string
+	BatchID        string
+	RowKey         string
 }{
 	ID:             "id",
 	Date:           "date",
@@ -55,6 +64,10 @@ var PoloniexWithdrawalColumns = struct {
 	AmountMinusFee: "amount_minus_fee",
 	Address:        "address",
 	Status:         "status",
+	PortfolioID:    "portfolio_id",
+	Account:        "account",
+	BatchID:        "batch_id",
+	RowKey:         "row_key",
 }
 
 // Generated where
@@ -68,6 +81,10 @@ var PoloniexWithdrawalWhere = struct {
 	AmountMinusFee whereHelpertypes_Decimal
 	Address        whereHelperstring
 	Status         whereHelperstring
+	PortfolioID    whereHelperint
+	Account        whereHelperstring
+	BatchID        whereHelpernull_Int
+	RowKey         whereHelpernull_String
 }{
 	ID:             whereHelperint{field: "`poloniex_withdrawals`.`id`"},
 	Date:           whereHelpertime_Time{field: "`poloniex_withdrawals`.`date`"},
@@ -77,6 +94,10 @@ var PoloniexWithdrawalWhere = struct {
 	AmountMinusFee: whereHelpertypes_Decimal{field: "`poloniex_withdrawals`.`amount_minus_fee`"},
 	Address:        whereHelperstring{field: "`poloniex_withdrawals`.`address`"},
 	Status:         whereHelperstring{field: "`poloniex_withdrawals`.`status`"},
+	PortfolioID:    whereHelperint{field: "`poloniex_withdrawals`.`portfolio_id`"},
+	Account:        whereHelperstring{field: "`poloniex_withdrawals`.`account`"},
+	BatchID:        whereHelpernull_Int{field: "`poloniex_withdrawals`.`batch_id`"},
+	RowKey:         whereHelpernull_String{field: "`poloniex_withdrawals`.`row_key`"},
 }
 
 // PoloniexWithdrawalRels is where relationship names are stored.
@@ -96,9 +117,9 @@ func (*poloniexWithdrawalR) NewStruct() *poloniexWithdrawalR {
 type poloniexWithdrawalL struct{}
 
 var (
-	poloniexWithdrawalAllColumns            = []string{"id", "date", "currency", "amount", "fee_deducted", "amount_minus_fee", "address", "status"}
-	poloniexWithdrawalColumnsWithoutDefault = []string{"date", "currency", "amount", "fee_deducted", "amount_minus_fee", "address", "status"}
-	poloniexWithdrawalColumnsWithDefault    = []string{"id"}
+	poloniexWithdrawalAllColumns            = []string{"id", "date", "currency", "amount", "fee_deducted", "amount_minus_fee", "address", "status", "portfolio_id", "account", "batch_id", "row_key"}
+	poloniexWithdrawalColumnsWithoutDefault = []string{"date", "currency", "amount", "fee_deducted", "amount_minus_fee", "address", "status", "account", "batch_id", "row_key"}
+	poloniexWithdrawalColumnsWithDefault    = []string{"id", "portfolio_id"}
 	poloniexWithdrawalPrimaryKeyColumns     = []string{"id"}
 )
 
@@ -27,6 +27,8 @@ type Transaction struct {
 	Time        time.Time `boil:"time" json:"time" toml:"time" yaml:"time"`
 	WalletCode  string    `boil:"wallet_code" json:"wallet_code" toml:"wallet_code" yaml:"wallet_code"`
 	WalletTid   int       `boil:"wallet_tid" json:"wallet_tid" toml:"wallet_tid" yaml:"wallet_tid"`
+	PortfolioID int       `boil:"portfolio_id" json:"portfolio_id" toml:"portfolio_id" yaml:"portfolio_id"`
+	Account     string    `boil:"account" json:"account" toml:"account" yaml:"account"`
 	Description string    `boil:"description" json:"description" toml:"description" yaml:"description"`
 
 	R *transactionR `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -38,12 +40,16 @@ var TransactionColumns = struct {
 	Time        string
 	WalletCode  string
 	WalletTid   string
+	PortfolioID string
+	Account     string
 	Description string
 }{
 	ID:          "id",
 	Time:        "time",
 	WalletCode:  "wallet_code",
 	WalletTid:   "wallet_tid",
+	PortfolioID: "portfolio_id",
+	Account:     "account",
 	Description: "description",
 }
 
@@ -54,12 +60,16 @@ var TransactionWhere = struct {
 	Time        whereHelpertime_Time
 	WalletCode  whereHelperstring
 	WalletTid   whereHelperint
+	PortfolioID whereHelperint
+	Account     whereHelperstring
 	Description whereHelperstring
 }{
 	ID:          whereHelperint{field: "`transactions`.`id`"},
 	Time:        whereHelpertime_Time{field: "`transactions`.`time`"},
 	WalletCode:  whereHelperstring{field: "`transactions`.`wallet_code`"},
 	WalletTid:   whereHelperint{field: "`transactions`.`wallet_tid`"},
+	PortfolioID: whereHelperint{field: "`transactions`.`portfolio_id`"},
+	Account:     whereHelperstring{field: "`transactions`.`account`"},
 	Description: whereHelperstring{field: "`transactions`.`description`"},
 }
 
@@ -80,9 +90,9 @@ func (*transactionR) NewStruct() *transactionR {
 type transactionL struct{}
 
 var (
-	transactionAllColumns            = []string{"id", "time", "wallet_code", "wallet_tid", "description"}
-	transactionColumnsWithoutDefault = []string{"time", "wallet_code", "wallet_tid", "description"}
-	transactionColumnsWithDefault    = []string{"id"}
+	transactionAllColumns            = []string{"id", "time", "wallet_code", "wallet_tid", "portfolio_id", "account", "description"}
+	transactionColumnsWithoutDefault = []string{"time", "wallet_code", "wallet_tid", "account", "description"}
+	transactionColumnsWithDefault    = []string{"id", "portfolio_id"}
 	transactionPrimaryKeyColumns     = []string{"id"}
 )
 
@@ -0,0 +1,167 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package appconfig reads the config file shared by the etl, query and config commands, which holds the database
+// connection and the defaults of portfolios, years and market prices. Environment variables override the file.
+package appconfig
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+// Database drivers
+const (
+	DriverMySQL  = "mysql"
+	DriverSQLite = "sqlite3"
+)
+
+// Config is the application config
+type Config struct {
+	Database     Database `yaml:"database"`
+	Fiat         string   `yaml:"fiat"`          // fiat of portfolios created without it
+	Timezone     string   `yaml:"timezone"`      // tax timezone of portfolios created without it
+	CostMethod   string   `yaml:"cost_method"`   // cost method (wam, mam) of years which are not configured
+	PriceSources []string `yaml:"price_sources"` // sources of market prices (coingecko, yahoofinance, cdd.<exchange>) by priority
+}
+
+// Database is the connection of the database
+type Database struct {
+	Driver string `yaml:"driver"` // mysql or sqlite3
+	DSN    string `yaml:"dsn"`    // the MySQL database of docker-compose.yml, or eupholio.db for sqlite3 if empty
+}
+
+// DefaultPath returns the path of the config file under $XDG_CONFIG_HOME, which is ~/.config if not set
+func DefaultPath() string {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, "eupholio", "config.yaml")
+}
+
+// Load reads a config file, which is $EUPHOLIO_CONFIG or DefaultPath if path is empty.
+// The file at DefaultPath is optional, and the built-in defaults are used without it.
+// EUPHOLIO_DB_DRIVER, EUPHOLIO_DB_DSN, EUPHOLIO_FIAT, EUPHOLIO_TIMEZONE, EUPHOLIO_COST_METHOD and
+// EUPHOLIO_PRICE_SOURCES (comma separated) override the file.
+func Load(path string) (*Config, error) {
+	if path == "" {
+		path = os.Getenv("EUPHOLIO_CONFIG")
+	}
+	optional := false
+	if path == "" {
+		path = DefaultPath()
+		optional = true
+	}
+	c := &Config{}
+	if path != "" {
+		b, err := ioutil.ReadFile(path)
+		if err != nil && !(optional && os.IsNotExist(err)) {
+			return nil, err
+		}
+		if err := yaml.UnmarshalStrict(b, c); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	c.overrideByEnv()
+	if err := c.init(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return c, nil
+}
+
+func (c *Config) overrideByEnv() {
+	for env, value := range map[string]*string{
+		"EUPHOLIO_DB_DRIVER":   &c.Database.Driver,
+		"EUPHOLIO_DB_DSN":      &c.Database.DSN,
+		"EUPHOLIO_FIAT":        &c.Fiat,
+		"EUPHOLIO_TIMEZONE":    &c.Timezone,
+		"EUPHOLIO_COST_METHOD": &c.CostMethod,
+	} {
+		if v := os.Getenv(env); v != "" {
+			*value = v
+		}
+	}
+	if v := os.Getenv("EUPHOLIO_PRICE_SOURCES"); v != "" {
+		c.PriceSources = strings.Split(v, ",")
+	}
+}
+
+// init validates the config and fills default values
+func (c *Config) init() error {
+	defaults := eupholio.NewDefaults()
+	switch c.Database.Driver {
+	case "":
+		c.Database.Driver = DriverMySQL
+	case DriverMySQL, DriverSQLite:
+	default:
+		return fmt.Errorf("unknown database driver %s", c.Database.Driver)
+	}
+	if c.Database.DSN == "" {
+		if c.Database.Driver == DriverSQLite {
+			c.Database.DSN = "eupholio.db"
+		} else {
+			c.Database.DSN = "eupholio:eupholio@tcp(localhost)/eupholio?parseTime=true"
+		}
+	}
+	if c.Fiat == "" {
+		c.Fiat = defaults.Fiat
+	}
+	if c.Timezone == "" {
+		c.Timezone = defaults.Timezone
+	}
+	if _, err := time.LoadLocation(c.Timezone); err != nil {
+		return err
+	}
+	if c.CostMethod == "" {
+		c.CostMethod = defaults.CostMethod
+	}
+	switch c.CostMethod {
+	case "wam", "mam":
+	default:
+		return fmt.Errorf("unknown cost method %s", c.CostMethod)
+	}
+	for i, source := range c.PriceSources {
+		c.PriceSources[i] = strings.TrimSpace(source)
+	}
+	return nil
+}
+
+// Defaults returns the defaults of portfolios, years and market prices
+func (c *Config) Defaults() *eupholio.Defaults {
+	return &eupholio.Defaults{
+		Fiat:         c.Fiat,
+		Timezone:     c.Timezone,
+		CostMethod:   c.CostMethod,
+		PriceSources: c.PriceSources,
+	}
+}
@@ -0,0 +1,87 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package appconfig
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var testConfig = `
+database:
+  driver: sqlite3
+  dsn: /var/lib/eupholio/eupholio.db
+fiat: USD
+timezone: America/New_York
+cost_method: mam
+price_sources: [coingecko, yahoofinance]
+`
+
+func TestLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "appconfig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	os.Setenv("XDG_CONFIG_HOME", dir)
+	defer os.Unsetenv("XDG_CONFIG_HOME")
+
+	c, err := Load("")
+	if err != nil {
+		t.Fatalf("a missing config file at the default path should be ignored: %v", err)
+	}
+	if c.Database.Driver != DriverMySQL || c.Fiat != "JPY" || c.Timezone != "Asia/Tokyo" || c.CostMethod != "wam" {
+		t.Errorf("unexpected defaults %+v", c)
+	}
+
+	path := DefaultPath()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path, []byte(testConfig), 0644); err != nil {
+		t.Fatal(err)
+	}
+	os.Setenv("EUPHOLIO_FIAT", "EUR")
+	defer os.Unsetenv("EUPHOLIO_FIAT")
+	c, err = Load("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Database.Driver != DriverSQLite || c.Database.DSN != "/var/lib/eupholio/eupholio.db" || c.Fiat != "EUR" ||
+		c.Timezone != "America/New_York" || c.CostMethod != "mam" {
+		t.Errorf("unexpected config %+v", c)
+	}
+	if d := c.Defaults(); d.PriceSourcePriority("yahoofinance") != 1 || d.PriceSourcePriority("cdd.binance") != 2 {
+		t.Errorf("unexpected price sources %v", d.PriceSources)
+	}
+
+	if _, err := Load(filepath.Join(dir, "missing.yaml")); err == nil {
+		t.Error("a missing config file given explicitly should be an error")
+	}
+	if err := ioutil.WriteFile(path, []byte("cost_method: fifo\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(path); err == nil {
+		t.Error("an unknown cost method should be an error")
+	}
+}
@@ -0,0 +1,266 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package bitflyer
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+)
+
+// DefaultBaseURL is the base URL of bitFlyer Lightning API
+const DefaultBaseURL = "https://api.bitflyer.com"
+
+// API paths
+const (
+	PathExecutions  = "/v1/me/getexecutions"
+	PathDeposits    = "/v1/me/getdeposits"
+	PathWithdrawals = "/v1/me/getwithdrawals"
+	PathCoinIns     = "/v1/me/getcoinins"
+	PathCoinOuts    = "/v1/me/getcoinouts"
+)
+
+const apiTimeFormat = "2006-01-02T15:04:05.999999999"
+
+// StatusCompleted is the status of completed deposits and withdrawals
+const StatusCompleted = "COMPLETED"
+
+// ErrUnauthorized is returned when the API rejects the credentials
+var ErrUnauthorized = errors.New("bitflyer api: unauthorized")
+
+// API is the private API of bitFlyer
+type API interface {
+	GetExecutions(ctx context.Context, productCode string, page Page) ([]*Execution, error)
+	GetDeposits(ctx context.Context, page Page) ([]*Deposit, error)
+	GetWithdrawals(ctx context.Context, page Page) ([]*Deposit, error)
+	GetCoinIns(ctx context.Context, page Page) ([]*CoinIn, error)
+	GetCoinOuts(ctx context.Context, page Page) ([]*CoinOut, error)
+}
+
+// Page is a pagination parameter, which returns records whose id is less than Before and greater than After
+type Page struct {
+	Count  int
+	Before int64
+	After  int64
+}
+
+// Execution is an execution of a child order
+type Execution struct {
+	ID                     int64        `json:"id"`
+	ChildOrderID           string       `json:"child_order_id"`
+	Side                   string       `json:"side"`
+	Price                  *decimal.Big `json:"price"`
+	Size                   *decimal.Big `json:"size"`
+	Commission             *decimal.Big `json:"commission"`
+	ExecDate               Time         `json:"exec_date"`
+	ChildOrderAcceptanceID string       `json:"child_order_acceptance_id"`
+}
+
+// Deposit is a deposit or a withdrawal of fiat currency
+type Deposit struct {
+	ID           int64        `json:"id"`
+	OrderID      string       `json:"order_id"`
+	CurrencyCode string       `json:"currency_code"`
+	Amount       *decimal.Big `json:"amount"`
+	Status       string       `json:"status"`
+	EventDate    Time         `json:"event_date"`
+}
+
+// CoinIn is a deposit of crypto currency
+type CoinIn struct {
+	ID           int64        `json:"id"`
+	OrderID      string       `json:"order_id"`
+	CurrencyCode string       `json:"currency_code"`
+	Amount       *decimal.Big `json:"amount"`
+	Address      string       `json:"address"`
+	TxHash       string       `json:"tx_hash"`
+	Status       string       `json:"status"`
+	EventDate    Time         `json:"event_date"`
+}
+
+// CoinOut is a withdrawal of crypto currency
+type CoinOut struct {
+	ID            int64        `json:"id"`
+	OrderID       string       `json:"order_id"`
+	CurrencyCode  string       `json:"currency_code"`
+	Amount        *decimal.Big `json:"amount"`
+	Address       string       `json:"address"`
+	TxHash        string       `json:"tx_hash"`
+	Fee           *decimal.Big `json:"fee"`
+	AdditionalFee *decimal.Big `json:"additional_fee"`
+	Status        string       `json:"status"`
+	EventDate     Time         `json:"event_date"`
+}
+
+// Time is a time in UTC, which is formatted without zone by the API
+type Time struct {
+	time.Time
+}
+
+// UnmarshalJSON parses a time with or without zone
+func (t *Time) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		v, err = time.Parse(apiTimeFormat, s)
+		if err != nil {
+			return err
+		}
+	}
+	t.Time = v
+	return nil
+}
+
+// Client is a client of the private API
+type Client struct {
+	baseURL    string
+	key        string
+	secret     string
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+// NewClient creates a client of the API at baseURL, which is DefaultBaseURL unless specified
+func NewClient(baseURL, key, secret string) *Client {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		key:        key,
+		secret:     secret,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		now:        time.Now,
+	}
+}
+
+// BaseURL returns the base URL of the API
+func (c *Client) BaseURL() string {
+	return c.baseURL
+}
+
+// GetExecutions returns executions of the product
+func (c *Client) GetExecutions(ctx context.Context, productCode string, page Page) ([]*Execution, error) {
+	var executions []*Execution
+	query := url.Values{}
+	query.Set("product_code", productCode)
+	err := c.get(ctx, PathExecutions, page.query(query), &executions)
+	return executions, err
+}
+
+// GetDeposits returns deposits of fiat currency
+func (c *Client) GetDeposits(ctx context.Context, page Page) ([]*Deposit, error) {
+	var deposits []*Deposit
+	err := c.get(ctx, PathDeposits, page.query(url.Values{}), &deposits)
+	return deposits, err
+}
+
+// GetWithdrawals returns withdrawals of fiat currency
+func (c *Client) GetWithdrawals(ctx context.Context, page Page) ([]*Deposit, error) {
+	var withdrawals []*Deposit
+	err := c.get(ctx, PathWithdrawals, page.query(url.Values{}), &withdrawals)
+	return withdrawals, err
+}
+
+// GetCoinIns returns deposits of crypto currencies
+func (c *Client) GetCoinIns(ctx context.Context, page Page) ([]*CoinIn, error) {
+	var coinIns []*CoinIn
+	err := c.get(ctx, PathCoinIns, page.query(url.Values{}), &coinIns)
+	return coinIns, err
+}
+
+// GetCoinOuts returns withdrawals of crypto currencies
+func (c *Client) GetCoinOuts(ctx context.Context, page Page) ([]*CoinOut, error) {
+	var coinOuts []*CoinOut
+	err := c.get(ctx, PathCoinOuts, page.query(url.Values{}), &coinOuts)
+	return coinOuts, err
+}
+
+func (c *Client) get(ctx context.Context, path string, query url.Values, v interface{}) error {
+	pathWithQuery := path
+	if len(query) > 0 {
+		pathWithQuery += "?" + query.Encode()
+	}
+	request, err := http.NewRequest(http.MethodGet, c.baseURL+pathWithQuery, nil)
+	if err != nil {
+		return err
+	}
+	timestamp := strconv.FormatInt(c.now().Unix(), 10)
+	request.Header.Set("ACCESS-KEY", c.key)
+	request.Header.Set("ACCESS-TIMESTAMP", timestamp)
+	request.Header.Set("ACCESS-SIGN", Sign(c.secret, timestamp, http.MethodGet, pathWithQuery, ""))
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := c.httpClient.Do(request.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	switch {
+	case response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden:
+		return ErrUnauthorized
+	case response.StatusCode != http.StatusOK:
+		return fmt.Errorf("bitflyer api: %s: %s", path, response.Status)
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("bitflyer api: %s: %w", path, err)
+	}
+	return nil
+}
+
+func (p Page) query(q url.Values) url.Values {
+	if p.Count > 0 {
+		q.Set("count", strconv.Itoa(p.Count))
+	}
+	if p.Before > 0 {
+		q.Set("before", strconv.FormatInt(p.Before, 10))
+	}
+	if p.After > 0 {
+		q.Set("after", strconv.FormatInt(p.After, 10))
+	}
+	return q
+}
+
+// Sign returns the signature of a request, which is HMAC-SHA256 of timestamp, method, path with query and body
+func Sign(secret, timestamp, method, path, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + method + path + body))
+	return hex.EncodeToString(mac.Sum(nil))
+}
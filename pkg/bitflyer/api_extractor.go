@@ -0,0 +1,424 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package bitflyer
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+	null "github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+const (
+	defaultPageCount = 100
+	defaultMaxPages  = 1000
+	defaultInterval  = 200 * time.Millisecond
+)
+
+// APIExtractor fetches executions, deposits and withdrawals from the API and stores them to bf_transactions.
+// Records which have been stored are skipped by their source ids, or by their natural keys for executions
+// imported from CSV files, so it can be executed repeatedly.
+type APIExtractor struct {
+	api          API
+	productCodes []string
+	count        int
+	maxPages     int
+	interval     time.Duration
+}
+
+// NewAPIExtractor creates an extractor which fetches executions of the spot products (e.g. BTC_JPY)
+func NewAPIExtractor(api API, productCodes []string) *APIExtractor {
+	return &APIExtractor{
+		api:          api,
+		productCodes: productCodes,
+		count:        defaultPageCount,
+		maxPages:     defaultMaxPages,
+		interval:     defaultInterval,
+	}
+}
+
+// Execute stores transactions which are not stored yet, and returns the number of them
+func (e *APIExtractor) Execute(ctx context.Context, db boil.ContextExecutor, options ...eupholio.Option) (int, error) {
+	config := &eupholio.Config{}
+	for _, o := range options {
+		o(config)
+	}
+	return e.Store(ctx, NewRepository(db), config.Account)
+}
+
+// Store fetches executions after the last stored one of each product and transfers, and stores ones which
+// are not stored yet. Executions imported from CSV files are matched by their natural keys, as they have no source ids.
+func (e *APIExtractor) Store(ctx context.Context, repository Repository, account string) (int, error) {
+	after := make(map[string]int64)
+	for _, productCode := range e.productCodes {
+		id, err := repository.FindLastExecutionID(ctx, productCode)
+		if err != nil {
+			return 0, err
+		}
+		after[productCode] = id
+	}
+
+	trs, err := e.Fetch(ctx, after)
+	if err != nil {
+		return 0, err
+	}
+
+	var ids []string
+	for _, tr := range trs {
+		ids = append(ids, tr.SourceID.String)
+	}
+	stored, err := repository.FindSourceIDs(ctx, ids)
+	if err != nil {
+		return 0, err
+	}
+	imported, err := findExecutionKeys(ctx, repository, trs, false)
+	if err != nil {
+		return 0, err
+	}
+
+	var news models.BFTransactionSlice
+	for _, tr := range trs {
+		if stored[tr.SourceID.String] || imported.take(tr) {
+			continue
+		}
+		stored[tr.SourceID.String] = true
+		tr.Account = account
+		news = append(news, tr)
+	}
+	log.Println(len(trs)-len(news), "transactions are already stored")
+	if err := repository.CreateTransactions(ctx, news); err != nil {
+		return 0, err
+	}
+	return len(news), nil
+}
+
+// executionKeys counts stored executions by their natural keys
+type executionKeys map[string]int
+
+// findExecutionKeys finds executions stored around the period of executions of trs, which are
+// fetched from the API if fromAPI is true, or imported from CSV files otherwise. The period is
+// extended by a day to cover executions of the other source around its ends.
+func findExecutionKeys(ctx context.Context, repository Repository, trs models.BFTransactionSlice, fromAPI bool) (executionKeys, error) {
+	keys := make(executionKeys)
+	var start, end time.Time
+	for _, tr := range trs {
+		if !isExecution(tr) {
+			continue
+		}
+		if start.IsZero() || tr.TRDate.Before(start) {
+			start = tr.TRDate
+		}
+		if end.IsZero() || tr.TRDate.After(end) {
+			end = tr.TRDate
+		}
+	}
+	if start.IsZero() {
+		return keys, nil
+	}
+	stored, err := repository.FindTransactions(ctx, start.Add(-24*time.Hour), end.Add(24*time.Hour))
+	if err != nil {
+		return nil, err
+	}
+	for _, tr := range stored {
+		if isExecution(tr) && tr.SourceID.Valid == fromAPI {
+			keys[ExecutionKey(tr)]++
+		}
+	}
+	return keys, nil
+}
+
+// take reports whether an execution which has the same natural key as tr is stored, and consumes it
+func (k executionKeys) take(tr *models.BFTransaction) bool {
+	if !isExecution(tr) {
+		return false
+	}
+	key := ExecutionKey(tr)
+	if k[key] == 0 {
+		return false
+	}
+	k[key]--
+	return true
+}
+
+func isExecution(tr *models.BFTransaction) bool {
+	return tr.TRType == TrTypeBuy || tr.TRType == TrTypeSell
+}
+
+// ExecutionKey returns the natural key of an execution, which is common to the CSV files and the API.
+// The time is the wall clock in JST truncated to seconds, as the CSV files have neither time zones
+// nor fractional seconds.
+func ExecutionKey(tr *models.BFTransaction) string {
+	date := tr.TRDate.In(jst)
+	return fmt.Sprintf("%s %d %s %s %s",
+		date.Format("2006-01-02 15:04:05"),
+		tr.TRType,
+		tr.Currency1,
+		formatDecimal(abs(tr.Currency1Quantity.Big)),
+		formatDecimal(tr.TRPrice.Big))
+}
+
+func formatDecimal(x *decimal.Big) string {
+	if x == nil {
+		return "0"
+	}
+	return fmt.Sprintf("%f", new(decimal.Big).Copy(x).Reduce())
+}
+
+// Fetch fetches transactions from the API. Executions of a product are fetched after the id of after[productCode] if any.
+func (e *APIExtractor) Fetch(ctx context.Context, after map[string]int64) (models.BFTransactionSlice, error) {
+	var trs models.BFTransactionSlice
+
+	for _, productCode := range e.productCodes {
+		err := e.paginate(after[productCode], func(page Page) ([]int64, error) {
+			executions, err := e.api.GetExecutions(ctx, productCode, page)
+			if err != nil {
+				return nil, err
+			}
+			var ids []int64
+			for _, ex := range executions {
+				tr, err := ExecutionTransaction(productCode, ex)
+				if err != nil {
+					return nil, err
+				}
+				trs = append(trs, tr)
+				ids = append(ids, ex.ID)
+			}
+			return ids, nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("executions of %s: %w", productCode, err)
+		}
+	}
+
+	err := e.paginate(0, func(page Page) ([]int64, error) {
+		deposits, err := e.api.GetDeposits(ctx, page)
+		if err != nil {
+			return nil, err
+		}
+		var ids []int64
+		for _, d := range deposits {
+			if d.Status == StatusCompleted {
+				trs = append(trs, DepositTransaction(d))
+			}
+			ids = append(ids, d.ID)
+		}
+		return ids, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("deposits: %w", err)
+	}
+
+	err = e.paginate(0, func(page Page) ([]int64, error) {
+		withdrawals, err := e.api.GetWithdrawals(ctx, page)
+		if err != nil {
+			return nil, err
+		}
+		var ids []int64
+		for _, w := range withdrawals {
+			if w.Status == StatusCompleted {
+				trs = append(trs, WithdrawalTransaction(w))
+			}
+			ids = append(ids, w.ID)
+		}
+		return ids, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("withdrawals: %w", err)
+	}
+
+	err = e.paginate(0, func(page Page) ([]int64, error) {
+		coinIns, err := e.api.GetCoinIns(ctx, page)
+		if err != nil {
+			return nil, err
+		}
+		var ids []int64
+		for _, c := range coinIns {
+			if c.Status == StatusCompleted {
+				trs = append(trs, CoinInTransaction(c))
+			}
+			ids = append(ids, c.ID)
+		}
+		return ids, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("coin ins: %w", err)
+	}
+
+	err = e.paginate(0, func(page Page) ([]int64, error) {
+		coinOuts, err := e.api.GetCoinOuts(ctx, page)
+		if err != nil {
+			return nil, err
+		}
+		var ids []int64
+		for _, c := range coinOuts {
+			if c.Status == StatusCompleted {
+				trs = append(trs, CoinOutTransaction(c))
+			}
+			ids = append(ids, c.ID)
+		}
+		return ids, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("coin outs: %w", err)
+	}
+
+	return trs, nil
+}
+
+// paginate fetches pages from the newest one to the oldest one after the id of after, passing the oldest id of a page as "before" of the next page
+func (e *APIExtractor) paginate(after int64, fetch func(page Page) ([]int64, error)) error {
+	page := Page{Count: e.count, After: after}
+	for i := 0; i < e.maxPages; i++ {
+		if i > 0 && e.interval > 0 {
+			time.Sleep(e.interval)
+		}
+		ids, err := fetch(page)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		oldest := ids[0]
+		for _, id := range ids {
+			if id < oldest {
+				oldest = id
+			}
+		}
+		if page.Before > 0 && oldest >= page.Before {
+			return fmt.Errorf("page before %d returned id %d", page.Before, oldest)
+		}
+		page.Before = oldest
+		if len(ids) < page.Count {
+			return nil
+		}
+	}
+	return fmt.Errorf("more than %d pages", e.maxPages)
+}
+
+const executionSourcePrefix = "execution:"
+
+// ExecutionID returns the id of an execution fetched from the API
+func ExecutionID(tr *models.BFTransaction) (int64, bool) {
+	if !tr.SourceID.Valid || !strings.HasPrefix(tr.SourceID.String, executionSourcePrefix) {
+		return 0, false
+	}
+	id, err := strconv.ParseInt(strings.TrimPrefix(tr.SourceID.String, executionSourcePrefix), 10, 64)
+	return id, err == nil
+}
+
+// ExecutionTransaction converts an execution of a spot product quoted in JPY
+func ExecutionTransaction(productCode string, ex *Execution) (*models.BFTransaction, error) {
+	ss := strings.Split(productCode, "_")
+	if len(ss) != 2 || ss[1] != FiatCode {
+		return nil, fmt.Errorf("unsupported product %s", productCode)
+	}
+	base, quote := ss[0], ss[1]
+
+	commission := ex.Commission
+	if commission == nil {
+		commission = new(decimal.Big)
+	}
+	amount := new(decimal.Big).Mul(ex.Price, ex.Size)
+	tr := &models.BFTransaction{
+		TRDate:           ex.ExecDate.UTC(),
+		Currency:         base + "/" + quote,
+		TRPrice:          types.NewDecimal(ex.Price),
+		Currency1:        base,
+		Fee:              types.NewDecimal(new(decimal.Big).Neg(commission)),
+		Currency1JpyRate: types.NewNullDecimal(ex.Price),
+		Currency2:        null.StringFrom(quote),
+		DealType:         null.IntFrom(DealTypeNone),
+		OrderID:          ex.ChildOrderID,
+		Remarks:          null.StringFrom(""),
+		SourceID:         null.StringFrom(fmt.Sprintf("%s%d", executionSourcePrefix, ex.ID)),
+	}
+	switch ex.Side {
+	case "BUY":
+		tr.TRType = TrTypeBuy
+		tr.Currency1Quantity = types.NewDecimal(ex.Size)
+		tr.Currency2Quantity = types.NewDecimal(amount.Neg(amount))
+	case "SELL":
+		tr.TRType = TrTypeSell
+		tr.Currency1Quantity = types.NewDecimal(new(decimal.Big).Neg(ex.Size))
+		tr.Currency2Quantity = types.NewDecimal(amount)
+	default:
+		return nil, fmt.Errorf("execution %d: unknown side %s", ex.ID, ex.Side)
+	}
+	return tr, nil
+}
+
+// DepositTransaction converts a deposit of fiat currency
+func DepositTransaction(d *Deposit) *models.BFTransaction {
+	return transferTransaction(TrTypeDeposit, d.EventDate.Time, d.CurrencyCode, d.Amount, nil, d.OrderID, fmt.Sprintf("deposit:%d", d.ID))
+}
+
+// WithdrawalTransaction converts a withdrawal of fiat currency
+func WithdrawalTransaction(w *Deposit) *models.BFTransaction {
+	return transferTransaction(TrTypeWithdraw, w.EventDate.Time, w.CurrencyCode, new(decimal.Big).Neg(w.Amount), nil, w.OrderID, fmt.Sprintf("withdrawal:%d", w.ID))
+}
+
+// CoinInTransaction converts a deposit of crypto currency
+func CoinInTransaction(c *CoinIn) *models.BFTransaction {
+	return transferTransaction(TrTypeDeposit, c.EventDate.Time, c.CurrencyCode, c.Amount, nil, c.OrderID, fmt.Sprintf("coinin:%d", c.ID))
+}
+
+// CoinOutTransaction converts a withdrawal of crypto currency, whose fee is the sum of the fee and the additional fee
+func CoinOutTransaction(c *CoinOut) *models.BFTransaction {
+	fee := new(decimal.Big)
+	for _, f := range []*decimal.Big{c.Fee, c.AdditionalFee} {
+		if f != nil {
+			fee.Add(fee, f)
+		}
+	}
+	return transferTransaction(TrTypeTransfer, c.EventDate.Time, c.CurrencyCode, new(decimal.Big).Neg(c.Amount), fee.Neg(fee), c.OrderID, fmt.Sprintf("coinout:%d", c.ID))
+}
+
+func transferTransaction(trType int, date time.Time, currency string, quantity, fee *decimal.Big, orderID, sourceID string) *models.BFTransaction {
+	if fee == nil {
+		fee = new(decimal.Big)
+	}
+	return &models.BFTransaction{
+		TRDate:            date.UTC(),
+		Currency:          currency,
+		TRType:            trType,
+		TRPrice:           types.NewDecimal(new(decimal.Big)),
+		Currency1:         currency,
+		Currency1Quantity: types.NewDecimal(quantity),
+		Fee:               types.NewDecimal(fee),
+		Currency1JpyRate:  types.NewNullDecimal(nil),
+		Currency2Quantity: types.NewDecimal(new(decimal.Big)),
+		DealType:          null.IntFrom(DealTypeNone),
+		OrderID:           orderID,
+		Remarks:           null.StringFrom(""),
+		SourceID:          null.StringFrom(sourceID),
+	}
+}
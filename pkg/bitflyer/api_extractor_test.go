@@ -0,0 +1,122 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package bitflyer_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+
+	"github.com/eupholio/eupholio/pkg/bitflyer"
+	"github.com/eupholio/eupholio/pkg/repository/memory"
+)
+
+type fakeAPI struct {
+	executions []*bitflyer.Execution // from the newest one
+	afters     []int64
+}
+
+func (a *fakeAPI) GetExecutions(ctx context.Context, productCode string, page bitflyer.Page) ([]*bitflyer.Execution, error) {
+	a.afters = append(a.afters, page.After)
+	var ret []*bitflyer.Execution
+	for _, ex := range a.executions {
+		if (page.Before == 0 || ex.ID < page.Before) && ex.ID > page.After && len(ret) < page.Count {
+			ret = append(ret, ex)
+		}
+	}
+	return ret, nil
+}
+
+func (a *fakeAPI) GetDeposits(ctx context.Context, page bitflyer.Page) ([]*bitflyer.Deposit, error) {
+	return nil, nil
+}
+
+func (a *fakeAPI) GetWithdrawals(ctx context.Context, page bitflyer.Page) ([]*bitflyer.Deposit, error) {
+	return nil, nil
+}
+
+func (a *fakeAPI) GetCoinIns(ctx context.Context, page bitflyer.Page) ([]*bitflyer.CoinIn, error) {
+	return nil, nil
+}
+
+func (a *fakeAPI) GetCoinOuts(ctx context.Context, page bitflyer.Page) ([]*bitflyer.CoinOut, error) {
+	return nil, nil
+}
+
+func TestAPIExtractorStore(t *testing.T) {
+	ctx := context.Background()
+	trh, err := bitflyer.Extract(strings.NewReader(testExecutionCsv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := &memory.BitflyerRepository{}
+	if err := repo.CreateTransactions(ctx, trh.Transactions); err != nil {
+		t.Fatal(err)
+	}
+
+	execution := func(id int64, side, date string) *bitflyer.Execution {
+		t, _ := time.Parse("2006-01-02T15:04:05.999", date)
+		return &bitflyer.Execution{
+			ID: id, ChildOrderID: "JOR", Side: side, Price: decimal.New(1000000, 0), Size: decimal.New(1, 2),
+			Commission: new(decimal.Big), ExecDate: bitflyer.Time{Time: t},
+		}
+	}
+	api := &fakeAPI{executions: []*bitflyer.Execution{
+		execution(2, "BUY", "2020-01-02T03:04:05.456"), // imported from the CSV file
+		execution(1, "SELL", "2020-01-02T03:04:05.123"),
+	}}
+	e := bitflyer.NewAPIExtractor(api, []string{"BTC_JPY"})
+
+	n, err := e.Store(ctx, repo, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Errorf("expected 1 transaction stored but %d", n)
+	}
+
+	api.executions = append([]*bitflyer.Execution{execution(3, "BUY", "2020-01-03T00:00:00")}, api.executions...)
+	n, err = e.Store(ctx, repo, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Errorf("expected 1 transaction stored but %d", n)
+	}
+
+	if len(api.afters) != 2 || api.afters[0] != 0 || api.afters[1] != 1 {
+		t.Errorf("unexpected pages after %v", api.afters)
+	}
+	var sourceIDs []string
+	for _, tr := range repo.Transactions {
+		sourceIDs = append(sourceIDs, tr.SourceID.String)
+	}
+	if strings.Join(sourceIDs, ",") != ",execution:1,execution:3" {
+		t.Errorf("unexpected transactions %v", sourceIDs)
+	}
+}
+
+var testExecutionCsv = `"取引日時","通貨","取引種別","取引価格","通貨1","通貨1数量","手数料","通貨1の対円レート","通貨2","通貨2数量","自己・媒介","注文 ID","備考"
+"2020/01/02 12:04:05","BTC/JPY","買い","1,000,000","BTC","0.01","0","1,000,000","JPY","-10,000","媒介","JRF20200102-120405-000001",""
+`
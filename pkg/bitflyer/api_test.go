@@ -0,0 +1,130 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package bitflyer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+)
+
+func newFakeServer(t *testing.T, secret string) *httptest.Server {
+	executions := []map[string]interface{}{}
+	for id := 5; id >= 1; id-- {
+		side := "BUY"
+		if id%2 == 0 {
+			side = "SELL"
+		}
+		executions = append(executions, map[string]interface{}{
+			"id": id, "child_order_id": fmt.Sprintf("JOR-%d", id), "side": side,
+			"price": 1000000, "size": 0.01, "commission": 0.00001, "exec_date": "2020-01-02T03:04:05.123",
+		})
+	}
+	responses := map[string][]map[string]interface{}{
+		PathExecutions: executions,
+		PathDeposits: {
+			{"id": 2, "order_id": "MDP-2", "currency_code": "JPY", "amount": 100000, "status": "PENDING", "event_date": "2020-01-02T00:00:00"},
+			{"id": 1, "order_id": "MDP-1", "currency_code": "JPY", "amount": 100000, "status": "COMPLETED", "event_date": "2020-01-01T00:00:00"},
+		},
+		PathCoinOuts: {
+			{"id": 1, "order_id": "CWD-1", "currency_code": "BTC", "amount": 0.01, "fee": 0.0004, "additional_fee": 0.0001, "status": "COMPLETED", "event_date": "2020-01-03T00:00:00"},
+		},
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sign := Sign(secret, r.Header.Get("ACCESS-TIMESTAMP"), r.Method, r.URL.RequestURI(), "")
+		if r.Header.Get("ACCESS-KEY") != "key" || r.Header.Get("ACCESS-SIGN") != sign {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		count, _ := strconv.Atoi(r.URL.Query().Get("count"))
+		before, _ := strconv.Atoi(r.URL.Query().Get("before"))
+		after, _ := strconv.Atoi(r.URL.Query().Get("after"))
+		page := []map[string]interface{}{}
+		for _, record := range responses[r.URL.Path] {
+			id := record["id"].(int)
+			if (before == 0 || id < before) && id > after && len(page) < count {
+				page = append(page, record)
+			}
+		}
+		json.NewEncoder(w).Encode(page)
+	}))
+}
+
+func TestAPIExtractorFetch(t *testing.T) {
+	server := newFakeServer(t, "secret")
+	defer server.Close()
+
+	e := NewAPIExtractor(NewClient(server.URL, "key", "secret"), []string{"BTC_JPY"})
+	e.count = 2
+	e.interval = 0
+	trs, err := e.Fetch(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"execution:5", "execution:4", "execution:3", "execution:2", "execution:1", "deposit:1", "coinout:1"}
+	if len(trs) != len(expected) {
+		t.Fatalf("expected %d transactions but %d", len(expected), len(trs))
+	}
+	for i, tr := range trs {
+		if tr.SourceID.String != expected[i] {
+			t.Errorf("expected %s but %s", expected[i], tr.SourceID.String)
+		}
+	}
+
+	buy, sell := trs[0], trs[1]
+	if buy.TRType != TrTypeBuy || buy.Currency2Quantity.Big.Cmp(mustDecimal("-10000")) != 0 || buy.Fee.Big.Cmp(mustDecimal("-0.00001")) != 0 {
+		t.Errorf("unexpected buy %+v", buy)
+	}
+	if sell.TRType != TrTypeSell || sell.Currency1Quantity.Big.Cmp(mustDecimal("-0.01")) != 0 || sell.Currency2Quantity.Big.Cmp(mustDecimal("10000")) != 0 {
+		t.Errorf("unexpected sell %+v", sell)
+	}
+	if !buy.TRDate.Equal(time.Date(2020, time.January, 2, 3, 4, 5, 123000000, time.UTC)) {
+		t.Errorf("unexpected date %v", buy.TRDate)
+	}
+	coinOut := trs[6]
+	if coinOut.TRType != TrTypeTransfer || coinOut.Currency1Quantity.Big.Cmp(mustDecimal("-0.01")) != 0 || coinOut.Fee.Big.Cmp(mustDecimal("-0.0005")) != 0 {
+		t.Errorf("unexpected coin out %+v", coinOut)
+	}
+}
+
+func TestClientUnauthorized(t *testing.T) {
+	server := newFakeServer(t, "secret")
+	defer server.Close()
+
+	_, err := NewClient(server.URL, "key", "wrong").GetDeposits(context.Background(), Page{})
+	if !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("expected unauthorized but %v", err)
+	}
+}
+
+func mustDecimal(s string) *decimal.Big {
+	d, _ := new(decimal.Big).SetString(s)
+	return d
+}
@@ -0,0 +1,228 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package bitflyer
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+var reasonTypeIDMap = map[string]int{
+	"決済損益":           ReasonTypeSettlement,
+	"スワップポイント":       ReasonTypeSwapPoint,
+	"SFD":            ReasonTypeSFD,
+	"証拠金預入":          ReasonTypeDeposit,
+	"証拠金引出":          ReasonTypeWithdraw,
+	"Settlement P/L": ReasonTypeSettlement,
+	"Swap Point":     ReasonTypeSwapPoint,
+	"Deposit":        ReasonTypeDeposit,
+	"Withdrawal":     ReasonTypeWithdraw,
+}
+
+// CollateralExtractor for BitFlyer Lightning FX / CFD collateral history
+type CollateralExtractor struct {
+}
+
+// NewCollateralExtractor create an extractor for BitFlyer Lightning FX / CFD collateral history
+func NewCollateralExtractor() *CollateralExtractor {
+	return &CollateralExtractor{}
+}
+
+// Execute performs ETL
+func (e *CollateralExtractor) Execute(ctx context.Context, db boil.ContextExecutor, reader io.Reader, options ...eupholio.Option) error {
+	config := &eupholio.Config{}
+	for _, o := range options {
+		o(config)
+	}
+
+	if config.Overwrite {
+		n, err := models.BFCollaterals(eupholio.InPortfolio(ctx), models.BFCollateralWhere.Account.EQ(config.Account)).DeleteAll(ctx, db)
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			log.Println(n, "records deleted from", models.TableNames.BFCollaterals)
+		}
+	}
+
+	cs, errs, err := ExtractCollaterals(reader)
+	if err != nil {
+		return err
+	}
+	if err := config.HandleRowErrors(errs); err != nil {
+		return err
+	}
+	for _, c := range cs {
+		err := config.InsertRow(ctx, db, models.TableNames.BFCollaterals, c)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExtractCollaterals extracts collateral changes from a reader, and errors of rows which cannot be extracted
+func ExtractCollaterals(reader io.Reader) (models.BFCollateralSlice, eupholio.RowErrors, error) {
+	rows, err := extractCollateralRecords(NewReader(reader))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var cs models.BFCollateralSlice
+	var errs eupholio.RowErrors
+
+	for i, row := range rows {
+		date, err := time.ParseInLocation("2006/01/02 15:04:05", row.Get(CollateralDate), jst)
+		if err != nil {
+			errs = append(errs, &eupholio.RowError{Line: i + 2, Column: collateralColumnNames[En][CollateralDate], Err: err})
+			continue
+		}
+		t := row.ReasonType()
+		if t == ReasonTypeUnknown {
+			errs = append(errs, &eupholio.RowError{Line: i + 2, Column: collateralColumnNames[En][CollateralReason], Err: fmt.Errorf("unknown reason: %s", row.Get(CollateralReason))})
+			continue
+		}
+		change, err := row.GetAsDecimal(CollateralChange)
+		if err != nil {
+			errs = append(errs, eupholio.NewRowError(i+2, err))
+			continue
+		}
+		amount, err := row.GetAsDecimal(CollateralAmount)
+		if err != nil {
+			errs = append(errs, eupholio.NewRowError(i+2, err))
+			continue
+		}
+		c := &models.BFCollateral{
+			Date:       date,
+			Currency:   row.Get(CollateralCurrency),
+			Change:     types.NewDecimal(change),
+			Amount:     types.NewDecimal(amount),
+			ReasonType: t,
+			Reason:     row.Get(CollateralReason),
+		}
+		cs = append(cs, c)
+	}
+	return cs, errs, nil
+}
+
+func extractCollateralRecords(reader io.Reader) ([]CollateralRecord, error) {
+	r := csv.NewReader(reader)
+
+	csvHead, err := r.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	lang := ""
+	for _, l := range []string{En, Jp} {
+		err = ValidateCollateralColumnNames(l, csvHead)
+		if err == nil {
+			lang = l
+			break
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	csvRows, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	return MakeCollateralRecords(lang, csvHead, csvRows)
+}
+
+// ValidateCollateralColumnNames checks columns of collateral history
+func ValidateCollateralColumnNames(lang string, names []string) error {
+	remains := make(map[string]struct{})
+	cnSet := collateralColumnNamesSet[lang]
+	for k := range cnSet {
+		remains[k] = struct{}{}
+	}
+	for _, n := range names {
+		if _, ok := cnSet[n]; !ok {
+			return fmt.Errorf("unknown column %s found", n)
+		}
+		delete(remains, n)
+	}
+	if len(remains) > 0 {
+		for k := range remains {
+			return fmt.Errorf("column %s not found", k)
+		}
+	}
+	return nil
+}
+
+type CollateralRecord []string
+
+func (r CollateralRecord) Get(id CollateralColumnID) string {
+	return r[int(id)]
+}
+
+func (r CollateralRecord) ReasonType() int {
+	if id, ok := reasonTypeIDMap[r.Get(CollateralReason)]; ok {
+		return id
+	} else {
+		return ReasonTypeUnknown
+	}
+}
+
+func (r CollateralRecord) GetAsDecimal(id CollateralColumnID) (*decimal.Big, error) {
+	s := strings.ReplaceAll(r.Get(id), ",", "")
+	if b, ok := new(decimal.Big).SetString(s); ok {
+		return b, nil
+	} else {
+		return nil, &eupholio.ColumnError{Column: collateralColumnNames[En][id], Err: fmt.Errorf("invalid decimal %s", s)}
+	}
+}
+
+func MakeCollateralRecords(lang string, head []string, rows [][]string) ([]CollateralRecord, error) {
+	m := make(map[int]CollateralColumnID)
+	for i, k := range head {
+		if columnID, ok := collateralColumnNamesSet[lang][k]; ok {
+			m[i] = columnID
+		}
+	}
+	var ret []CollateralRecord
+	for _, row := range rows {
+		sorted := make([]string, numOfCollateralColumns)
+		for i, col := range row {
+			columnID := m[i]
+			sorted[columnID] = col
+		}
+		ret = append(ret, sorted)
+	}
+	return ret, nil
+}
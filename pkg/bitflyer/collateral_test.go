@@ -0,0 +1,56 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package bitflyer
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExtractCollaterals(t *testing.T) {
+	reader := strings.NewReader(testCollateralCsv)
+	cs, errs, err := ExtractCollaterals(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	if len(cs) != 4 {
+		t.Fatalf("expected 4 collaterals but %d", len(cs))
+	}
+	expected := []int{ReasonTypeDeposit, ReasonTypeSettlement, ReasonTypeSwapPoint, ReasonTypeSFD}
+	for i, c := range cs {
+		if c.ReasonType != expected[i] {
+			t.Errorf("expected reason type %d but %d", expected[i], c.ReasonType)
+		}
+	}
+	if cs[1].Change.Big.String() != "-1234" {
+		t.Errorf("unexpected change %s", cs[1].Change.Big.String())
+	}
+}
+
+var testCollateralCsv = `"日時","通貨","変動額","証拠金残高","理由"
+"2019/04/01 10:00:00","JPY","100,000","100,000","証拠金預入"
+"2019/04/02 11:30:00","JPY","-1,234","98,766","決済損益"
+"2019/04/03 00:00:00","JPY","-12","98,754","スワップポイント"
+"2019/04/03 12:00:00","JPY","-5","98,749","SFD"
+`
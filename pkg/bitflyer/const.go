@@ -20,9 +20,14 @@
 
 package bitflyer
 
+import "time"
+
 const WalletCode = "BF"
 const FiatCode = "JPY"
 
+// jst is the time zone of times in the CSV files, which is fixed regardless of the local time zone
+var jst = time.FixedZone("JST", 9*60*60)
+
 const (
 	TrTypeUnknown int = iota
 	TrTypeBuy
@@ -101,3 +106,60 @@ func init() {
 		}
 	}
 }
+
+// Lightning FX / CFD
+const (
+	FXWalletCode = "BF_FX"
+
+	// FXCurrency is a pseudo currency which accumulates JPY-denominated
+	// profits and losses of Lightning FX / CFD apart from spot positions
+	FXCurrency = "BF_FX_JPY"
+)
+
+const (
+	ReasonTypeUnknown int = iota
+	ReasonTypeSettlement
+	ReasonTypeSwapPoint
+	ReasonTypeSFD
+	ReasonTypeDeposit
+	ReasonTypeWithdraw
+)
+
+type CollateralColumnID int
+
+const (
+	CollateralDate CollateralColumnID = iota
+	CollateralCurrency
+	CollateralChange
+	CollateralAmount
+	CollateralReason
+	numOfCollateralColumns
+)
+
+var collateralColumnNames = map[string][]string{
+	En: {
+		"Date",
+		"Currency",
+		"Change",
+		"Collateral",
+		"Reason",
+	},
+	Jp: {
+		"日時",
+		"通貨",
+		"変動額",
+		"証拠金残高",
+		"理由",
+	},
+}
+
+var collateralColumnNamesSet = make(map[string]map[string]CollateralColumnID)
+
+func init() {
+	for k, l := range collateralColumnNames {
+		collateralColumnNamesSet[k] = make(map[string]CollateralColumnID)
+		for i, cn := range l {
+			collateralColumnNamesSet[k][cn] = CollateralColumnID(i)
+		}
+	}
+}
@@ -53,10 +53,8 @@ func (e *Extractor) Execute(ctx context.Context, db boil.ContextExecutor, reader
 		o(config)
 	}
 
-	repository := NewRepository(db)
-
 	if config.Overwrite {
-		n, err := models.BFTransactions().DeleteAll(ctx, db)
+		n, err := models.BFTransactions(eupholio.InPortfolio(ctx), models.BFTransactionWhere.Account.EQ(config.Account)).DeleteAll(ctx, db)
 		if err != nil {
 			return err
 		}
@@ -69,10 +67,22 @@ func (e *Extractor) Execute(ctx context.Context, db boil.ContextExecutor, reader
 	if err != nil {
 		return err
 	}
-	err = repository.CreateTransactions(ctx, hrs.Transactions)
+	if err := config.HandleRowErrors(hrs.Errors); err != nil {
+		return err
+	}
+	fetched, err := findExecutionKeys(ctx, NewRepository(db), hrs.Transactions, true)
 	if err != nil {
 		return err
 	}
+	for _, tr := range hrs.Transactions {
+		if fetched.take(tr) {
+			continue // fetched from the API
+		}
+		err := config.InsertRow(ctx, db, models.TableNames.BFTransactions, tr)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -99,6 +109,7 @@ func ValidateColumnNames(lang string, names []string) error {
 
 type TransactionHistory struct {
 	Transactions models.BFTransactionSlice
+	Errors       eupholio.RowErrors
 }
 
 func ExtractFromFile(path string) (*TransactionHistory, error) {
@@ -117,15 +128,18 @@ func Extract(reader io.Reader) (*TransactionHistory, error) {
 	}
 
 	var trs models.BFTransactionSlice
+	var errs eupholio.RowErrors
 
-	for _, row := range rows {
-		trDate, err := time.Parse("2006/01/02 15:04:05 MST", row.Get(TrDate)+" JST")
+	for i, row := range rows {
+		trDate, err := time.ParseInLocation("2006/01/02 15:04:05", row.Get(TrDate), jst)
 		if err != nil {
-			return nil, err
+			errs = append(errs, &eupholio.RowError{Line: i + 2, Column: columnNames[En][TrDate], Err: err})
+			continue
 		}
 		t := row.TrType()
 		if t == TrTypeUnknown {
-			return nil, fmt.Errorf("unknown type: %v", row)
+			errs = append(errs, &eupholio.RowError{Line: i + 2, Column: columnNames[En][TrType], Err: fmt.Errorf("unknown type: %s", row.Get(TrType))})
+			continue
 		}
 		tr := &models.BFTransaction{
 			TRDate:            trDate,
@@ -147,6 +161,7 @@ func Extract(reader io.Reader) (*TransactionHistory, error) {
 
 	trhistory := &TransactionHistory{
 		Transactions: trs,
+		Errors:       errs,
 	}
 	return trhistory, nil
 }
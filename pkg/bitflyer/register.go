@@ -0,0 +1,63 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package bitflyer
+
+import (
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/currency"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+func init() {
+	eupholio.RegisterExchange(&eupholio.Exchange{
+		Name:        "bitflyer",
+		Aliases:     []string{"bf"},
+		DisplayName: "bitFlyer",
+		FileTypes: []*eupholio.FileType{
+			{
+				Name:      "trade",
+				Match:     eupholio.LangMatch(ValidateColumnNames, En, Jp),
+				Extractor: func(*time.Location) eupholio.Extractor { return NewExecutor() },
+			},
+			{
+				Name:      "collateral",
+				Match:     eupholio.LangMatch(ValidateCollateralColumnNames, En, Jp),
+				Extractor: func(*time.Location) eupholio.Extractor { return NewCollateralExtractor() },
+			},
+		},
+		DefaultFileType: "trade",
+		Translator: func(db boil.ContextExecutor, fiat currency.Symbol) eupholio.Translator {
+			return NewTranslator(NewRepository(db), fiat)
+		},
+		RawTables: []eupholio.RawTable{
+			{Name: models.TableNames.BFTransactions, TimeColumn: "tr_date"},
+			{Name: models.TableNames.BFCollaterals, TimeColumn: "date"},
+		},
+		WalletCodes: map[string]string{
+			"BITFLYER":   "BF",
+			FXWalletCode: "BFx",
+		},
+	})
+}
@@ -23,6 +23,7 @@ package bitflyer
 import (
 	"context"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/friendsofgo/errors"
@@ -30,14 +31,19 @@ import (
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 
 	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/eupholio"
 )
 
-const timeFormat = "2006/01/02 15:04:05"
+const timeFormat = "2006-01-02 15:04:05"
 
 type Repository interface {
 	FindTransactions(ctx context.Context, start, end time.Time) (models.BFTransactionSlice, error)
 	FindTransactionsByYear(ctx context.Context, year int, location *time.Location) (models.BFTransactionSlice, error)
 	CreateTransactions(ctx context.Context, trs models.BFTransactionSlice) error
+	FindSourceIDs(ctx context.Context, ids []string) (map[string]bool, error)
+	FindLastExecutionID(ctx context.Context, productCode string) (int64, error)
+	FindCollaterals(ctx context.Context, start, end time.Time) (models.BFCollateralSlice, error)
+	CreateCollaterals(ctx context.Context, cs models.BFCollateralSlice) error
 }
 
 func NewRepository(db boil.ContextExecutor) Repository {
@@ -54,6 +60,7 @@ func (r *repository) FindTransactions(ctx context.Context, start time.Time, end
 	s := start.UTC().Format(timeFormat)
 	e := end.UTC().Format(timeFormat)
 	q := models.BFTransactions(
+		eupholio.InPortfolio(ctx),
 		qm.Where("tr_date >= ? AND tr_date < ?", s, e),
 		qm.OrderBy("tr_date ASC"),
 	)
@@ -73,6 +80,7 @@ func (r *repository) FindTransactionsByYear(ctx context.Context, year int, loc *
 
 func (r *repository) CreateTransactions(ctx context.Context, trs models.BFTransactionSlice) error {
 	for _, tr := range trs {
+		tr.PortfolioID = eupholio.PortfolioID(ctx)
 		err := tr.Insert(ctx, r.db, boil.Infer())
 		if err != nil {
 			return err
@@ -80,3 +88,77 @@ func (r *repository) CreateTransactions(ctx context.Context, trs models.BFTransa
 	}
 	return nil
 }
+
+// FindSourceIDs returns source ids of transactions which are stored
+func (r *repository) FindSourceIDs(ctx context.Context, ids []string) (map[string]bool, error) {
+	const chunk = 1000
+	found := make(map[string]bool)
+	for i := 0; i < len(ids); i += chunk {
+		j := i + chunk
+		if j > len(ids) {
+			j = len(ids)
+		}
+		args := make([]interface{}, j-i)
+		for k, id := range ids[i:j] {
+			args[k] = id
+		}
+		trs, err := models.BFTransactions(
+			eupholio.InPortfolio(ctx),
+			qm.Select(models.BFTransactionColumns.SourceID),
+			qm.WhereIn("source_id IN ?", args...),
+		).All(ctx, r.db)
+		if err != nil {
+			return nil, errors.WithMessage(err, "failed to find transactions")
+		}
+		for _, tr := range trs {
+			found[tr.SourceID.String] = true
+		}
+	}
+	return found, nil
+}
+
+// FindLastExecutionID returns the largest id of executions of a product fetched from the API, or 0 if none
+func (r *repository) FindLastExecutionID(ctx context.Context, productCode string) (int64, error) {
+	trs, err := models.BFTransactions(
+		eupholio.InPortfolio(ctx),
+		qm.Select(models.BFTransactionColumns.SourceID),
+		models.BFTransactionWhere.Currency.EQ(strings.Replace(productCode, "_", "/", 1)),
+		qm.Where("source_id LIKE ?", executionSourcePrefix+"%"),
+	).All(ctx, r.db)
+	if err != nil {
+		return 0, errors.WithMessage(err, "failed to find transactions")
+	}
+	var last int64
+	for _, tr := range trs {
+		if id, ok := ExecutionID(tr); ok && id > last {
+			last = id
+		}
+	}
+	return last, nil
+}
+
+func (r *repository) FindCollaterals(ctx context.Context, start time.Time, end time.Time) (models.BFCollateralSlice, error) {
+	s := start.UTC().Format(timeFormat)
+	e := end.UTC().Format(timeFormat)
+	cs, err := models.BFCollaterals(
+		eupholio.InPortfolio(ctx),
+		qm.Where("date >= ? AND date < ?", s, e),
+		qm.OrderBy("date ASC"),
+	).All(ctx, r.db)
+	if err != nil {
+		log.Println("failed to find collaterals:", "start:", s, "end:", e)
+		return nil, errors.WithMessage(err, "failed to find collaterals")
+	}
+	return cs, nil
+}
+
+func (r *repository) CreateCollaterals(ctx context.Context, cs models.BFCollateralSlice) error {
+	for _, c := range cs {
+		c.PortfolioID = eupholio.PortfolioID(ctx)
+		err := c.Insert(ctx, r.db, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
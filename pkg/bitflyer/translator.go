@@ -27,7 +27,6 @@ import (
 	"time"
 
 	"github.com/ericlagergren/decimal"
-	"github.com/volatiletech/sqlboiler/v4/boil"
 
 	"github.com/eupholio/eupholio/models"
 	"github.com/eupholio/eupholio/pkg/currency"
@@ -35,10 +34,17 @@ import (
 )
 
 type Translator struct {
+	repository Repository
+	fiat       currency.Symbol
 }
 
-func NewTranslator() *Translator {
-	return &Translator{}
+// NewTranslator creates a translator for bitFlyer. Prices on bitFlyer are in JPY,
+// and movements without cost are valued in the fiat of the portfolio
+func NewTranslator(repo Repository, fiat currency.Symbol) *Translator {
+	return &Translator{
+		repository: repo,
+		fiat:       fiat,
+	}
 }
 
 // Translate stores extracted transaction data to transaction table
@@ -47,7 +53,7 @@ func (t *Translator) Translate(ctx context.Context, repo eupholio.Repository, st
 	s := start.Format(tf)
 	e := end.Format(tf)
 
-	bitflyerRepository := NewRepository(repo)
+	bitflyerRepository := t.repository
 
 	n, err := repo.DeleteTransaction(ctx, WalletCode, start, end)
 	if err != nil {
@@ -66,7 +72,7 @@ func (t *Translator) Translate(ctx context.Context, repo eupholio.Repository, st
 	}
 
 	for _, tr := range trs {
-		transaction, err := repo.CreateTransaction(ctx, tr.TRDate, WalletCode, tr.ID)
+		transaction, err := repo.CreateTransaction(ctx, tr.TRDate, WalletCode, tr.Account, tr.ID)
 		if err != nil {
 			return err
 		}
@@ -75,7 +81,7 @@ func (t *Translator) Translate(ctx context.Context, repo eupholio.Repository, st
 			return err
 		}
 		transaction.Description = desc
-		_, err = transaction.Update(ctx, repo, boil.Infer())
+		err = repo.UpdateTransaction(ctx, transaction)
 		if err != nil {
 			return err
 		}
@@ -85,7 +91,73 @@ func (t *Translator) Translate(ctx context.Context, repo eupholio.Repository, st
 			return err
 		}
 	}
-	return nil
+
+	return t.translateCollaterals(ctx, repo, bitflyerRepository, start, end)
+}
+
+// translateCollaterals stores profits and losses of Lightning FX / CFD as
+// a pair of opening and closing entries of FXCurrency (1 unit per JPY), so
+// that they are accounted as JPY income or expense without affecting spot positions
+func (t *Translator) translateCollaterals(ctx context.Context, repo eupholio.Repository, bitflyerRepository Repository, start, end time.Time) error {
+	n, err := repo.DeleteTransaction(ctx, FXWalletCode, start, end)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		log.Println("deleted", n, "entries from events")
+	}
+
+	cs, err := bitflyerRepository.FindCollaterals(ctx, start, end)
+	if err != nil {
+		return err
+	}
+
+	jpy := currency.JPY.String()
+	fiat := t.fiat.String()
+	zero := new(decimal.Big)
+
+	var events []*models.Event
+	for _, c := range cs {
+		var desc string
+		switch c.ReasonType {
+		case ReasonTypeSettlement:
+			desc = "fx settlement"
+		case ReasonTypeSwapPoint:
+			desc = "fx swap point"
+		case ReasonTypeSFD:
+			desc = "fx sfd"
+		default:
+			continue // transfer between spot and collateral
+		}
+		if c.Currency != jpy {
+			return fmt.Errorf("unsupported collateral currency %s", c.Currency)
+		}
+		if c.Change.Big.Sign() == 0 {
+			continue
+		}
+
+		transaction, err := repo.CreateTransaction(ctx, c.Date, FXWalletCode, c.Account, c.ID)
+		if err != nil {
+			return err
+		}
+		transaction.Description = fmt.Sprintf("%s %sJPY", desc, new(decimal.Big).Copy(c.Change.Big).RoundToInt().String())
+		if err := repo.UpdateTransaction(ctx, transaction); err != nil {
+			return err
+		}
+
+		newEvent := eupholio.NewEventFunc(c.Date, transaction.ID)
+		quantity := abs(c.Change.Big)
+		if c.Change.Big.Sign() > 0 {
+			buy := newEvent(eupholio.EventTypeBuy, FXCurrency, quantity, fiat, zero)      // acquisition without cost
+			sell := newEvent(eupholio.EventTypeSell, FXCurrency, quantity, jpy, quantity) // profit
+			events = append(events, buy, sell)
+		} else {
+			buy := newEvent(eupholio.EventTypeBuy, FXCurrency, quantity, jpy, quantity) // acquisition at the cost of the loss
+			sell := newEvent(eupholio.EventTypeSell, FXCurrency, quantity, fiat, zero)  // loss
+			events = append(events, buy, sell)
+		}
+	}
+	return repo.CreateEvents(ctx, events)
 }
 
 func (t *Translator) translateTransaction(transaction *models.Transaction, tr *models.BFTransaction) (models.EventSlice, string, error) {
@@ -102,6 +174,15 @@ func (t *Translator) translateTransaction(transaction *models.Transaction, tr *m
 	tradingJpyPrice := tr.Currency1JpyRate.Big  // jpy / trading
 
 	jpy := currency.JPY.String()
+	fiat := t.fiat.String()
+
+	// fees of transactions fetched from the API have no rate, which are valued at the market price
+	feeEvent := func(f *decimal.Big) *models.Event {
+		if tradingJpyPrice == nil {
+			return newEvent(eupholio.EventTypeFee, tradingCurrency, f, tradingCurrency, f)
+		}
+		return newEvent(eupholio.EventTypeFee, tradingCurrency, f, jpy, mul(tradingJpyPrice, f))
+	}
 
 	switch tr.TRType {
 	case TrTypeBuy:
@@ -127,23 +208,27 @@ func (t *Translator) translateTransaction(transaction *models.Transaction, tr *m
 	case TrTypeReceive:
 		trading := tradingQuantity
 		f := neg(feeQuantity)
-		buy := newEvent(eupholio.EventTypeBuy, tradingCurrency, trading, jpy, zero)
-		fee := newEvent(eupholio.EventTypeFee, tradingCurrency, f, jpy, mul(tradingJpyPrice, f))
+		buy := newEvent(eupholio.EventTypeBuy, tradingCurrency, trading, fiat, zero)
+		fee := feeEvent(f)
 		events = append(events, buy, fee)
 		desc = fmt.Sprintf("receive %s", tr.Currency1)
 	case TrTypeTransfer:
 		f := neg(feeQuantity)
-		withdraw := newEvent(eupholio.EventTypeWithdraw, tradingCurrency, neg(tradingQuantity), jpy, zero)
-		fee := newEvent(eupholio.EventTypeFee, tradingCurrency, f, jpy, mul(tradingJpyPrice, f))
+		withdraw := newEvent(eupholio.EventTypeWithdraw, tradingCurrency, neg(tradingQuantity), fiat, zero)
+		fee := feeEvent(f)
 		events = append(events, fee, withdraw)
 		desc = fmt.Sprintf("transfer %s", tr.Currency1)
 	case TrTypeDeposit:
-		deposit := newEvent(eupholio.EventTypeDeposit, tr.Currency1, tradingQuantity, jpy, zero)
+		deposit := newEvent(eupholio.EventTypeDeposit, tr.Currency1, tradingQuantity, fiat, zero)
 		events = append(events, deposit)
 		desc = fmt.Sprintf("deposit %s", tr.Currency1)
+	case TrTypeWithdraw:
+		withdraw := newEvent(eupholio.EventTypeWithdraw, tr.Currency1, neg(tradingQuantity), fiat, zero)
+		events = append(events, withdraw)
+		desc = fmt.Sprintf("withdraw %s", tr.Currency1)
 	case TrTypeFee:
 		f := neg(tradingQuantity)
-		fee := newEvent(eupholio.EventTypeFee, tradingCurrency, f, jpy, mul(tradingJpyPrice, f))
+		fee := feeEvent(f)
 		events = append(events, fee)
 		desc = fmt.Sprintf("fee %s", tr.Currency1)
 	default:
@@ -0,0 +1,147 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package bitflyer_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+
+	"github.com/eupholio/eupholio/pkg/bitflyer"
+	"github.com/eupholio/eupholio/pkg/costmethod"
+	"github.com/eupholio/eupholio/pkg/costmethod/wam"
+	"github.com/eupholio/eupholio/pkg/currency"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+	"github.com/eupholio/eupholio/pkg/repository/memory"
+)
+
+func TestTranslateCollaterals(t *testing.T) {
+	ctx := context.Background()
+	jst := time.FixedZone("JST", 9*60*60)
+	cs, errs, err := bitflyer.ExtractCollaterals(strings.NewReader(testCollateralCsv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	for i, c := range cs {
+		c.ID = i + 1
+	}
+	raw := &memory.BitflyerRepository{Collaterals: cs}
+	repo := memory.New(currency.JPY)
+
+	start := time.Date(2019, 1, 1, 0, 0, 0, 0, jst)
+	end := time.Date(2020, 1, 1, 0, 0, 0, 0, jst)
+	if err := bitflyer.NewTranslator(raw, currency.JPY).Translate(ctx, repo, start, end); err != nil {
+		t.Fatal(err)
+	}
+
+	trs, err := eupholio.FindEventsOfTransactions(ctx, repo, 2019, jst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trs) != 4 {
+		t.Fatalf("expected 4 transactions but %d", len(trs))
+	}
+	for _, tr := range trs {
+		if tr.WalletCode != bitflyer.FXWalletCode || len(tr.Events) != 2 {
+			t.Fatalf("unexpected transaction %s %s with %d events", tr.WalletCode, tr.Description, len(tr.Events))
+		}
+		for _, e := range tr.Events {
+			if e.Currency != bitflyer.FXCurrency || e.Quantity.Big.Sign() <= 0 {
+				t.Errorf("unexpected event %s %s %s", e.Type, e.Currency, e.Quantity.Big)
+			}
+		}
+	}
+
+	if err := costmethod.CalculateFiatPrice(ctx, repo, 2019, jst, currency.JPY); err != nil {
+		t.Fatal(err)
+	}
+	if err := costmethod.UpdateBalanceByYear(ctx, repo, 2019, jst, currency.JPY, wam.NewCalculator()); err != nil {
+		t.Fatal(err)
+	}
+	balance, err := repo.FindBalanceByCurrencyAndYear(ctx, bitflyer.FXCurrency, 2019)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 3000 - 1234 - 12 - 5
+	if balance.Quantity.Big.Sign() != 0 || balance.Profit.Big.Cmp(decimal.New(1749, 0)) != 0 {
+		t.Errorf("unexpected balance: quantity %s profit %s", balance.Quantity.Big, balance.Profit.Big)
+	}
+}
+
+func TestTranslateInFiat(t *testing.T) {
+	ctx := context.Background()
+	jst := time.FixedZone("JST", 9*60*60)
+	trh, err := bitflyer.Extract(strings.NewReader(testTransferCsv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, tr := range trh.Transactions {
+		tr.ID = i + 1
+	}
+	raw := &memory.BitflyerRepository{Transactions: trh.Transactions}
+	repo := memory.New(currency.USD)
+
+	start := time.Date(2017, 1, 1, 0, 0, 0, 0, jst)
+	end := time.Date(2018, 1, 1, 0, 0, 0, 0, jst)
+	if err := bitflyer.NewTranslator(raw, currency.USD).Translate(ctx, repo, start, end); err != nil {
+		t.Fatal(err)
+	}
+
+	trs, err := eupholio.FindEventsOfTransactions(ctx, repo, 2017, jst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trs) != 3 {
+		t.Fatalf("expected 3 transactions but %d", len(trs))
+	}
+	for _, tr := range trs {
+		for _, e := range tr.Events {
+			expected := "JPY" // valued at the rate in JPY
+			switch e.Type {
+			case eupholio.EventTypeDeposit, eupholio.EventTypeWithdraw:
+				expected = "USD"
+			}
+			if e.BaseCurrency != expected {
+				t.Errorf("expected base currency %s of %s %s but %s", expected, e.Type, e.Currency, e.BaseCurrency)
+			}
+		}
+	}
+}
+
+var testTransferCsv = `"取引日時","通貨","取引種別","取引価格","通貨1","通貨1数量","手数料","通貨1の対円レート","通貨2","通貨2数量","自己・媒介","注文 ID","備考"
+"2017/08/20 10:00:00","BTC","外部送付","0","BTC","-0.005","-0.0004","454,359","","0","","MSE20170820-000001-000001",""
+"2017/08/16 23:46:37","BTC/JPY","買い","454,359","BTC","0.009","-0.0000135","454,359","JPY","-4,089","媒介","JOR20170816-000006-000001",""
+"2017/07/24 14:07:52","JPY","入金","0","JPY","100,000","0","0","","0","","MDP20170724-000002-000001",""
+`
+
+var testCollateralCsv = `"日時","通貨","変動額","証拠金残高","理由"
+"2019/04/01 10:00:00","JPY","100,000","100,000","証拠金預入"
+"2019/04/02 11:30:00","JPY","-1,234","98,766","決済損益"
+"2019/04/02 15:00:00","JPY","3,000","101,766","決済損益"
+"2019/04/03 00:00:00","JPY","-12","101,754","スワップポイント"
+"2019/04/03 12:00:00","JPY","-5","101,749","SFD"
+`
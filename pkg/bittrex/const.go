@@ -23,14 +23,18 @@ package bittrex
 const WalletCode = "BITTREX"
 
 const (
-	LimitSell = "LIMIT_SELL"
-	LimitBuy  = "LIMIT_BUY"
+	LimitSell  = "LIMIT_SELL"
+	LimitBuy   = "LIMIT_BUY"
+	MarketSell = "MARKET_SELL"
+	MarketBuy  = "MARKET_BUY"
 )
 
 const (
 	OrderTypeUnknown int = iota
 	OrderTypeLimitSell
 	OrderTypeLimitBuy
+	OrderTypeMarketSell
+	OrderTypeMarketBuy
 )
 
 // CokumnID is index type for columns
@@ -106,3 +110,53 @@ func init() {
 		columnNamesSet[cn] = ColumnID(i)
 	}
 }
+
+// Column names of the order history exported after 2020
+const (
+	V3IDColumn            = "Id"
+	V3MarketSymbolColumn  = "MarketSymbol"
+	V3DirectionColumn     = "Direction"
+	V3TypeColumn          = "Type"
+	V3QuantityColumn      = "Quantity"
+	V3LimitColumn         = "Limit"
+	V3CeilingColumn       = "Ceiling"
+	V3TimeInForceColumn   = "TimeInForce"
+	V3ClientOrderIDColumn = "ClientOrderId"
+	V3FillQuantityColumn  = "FillQuantity"
+	V3CommissionColumn    = "Commission"
+	V3ProceedsColumn      = "Proceeds"
+	V3StatusColumn        = "Status"
+	V3CreatedAtColumn     = "CreatedAt"
+	V3UpdatedAtColumn     = "UpdatedAt"
+	V3ClosedAtColumn      = "ClosedAt"
+	V3OrderToCancelColumn = "OrderToCancel"
+)
+
+var v3ColumnNames = []string{
+	V3IDColumn,
+	V3MarketSymbolColumn,
+	V3DirectionColumn,
+	V3TypeColumn,
+	V3QuantityColumn,
+	V3LimitColumn,
+	V3CeilingColumn,
+	V3TimeInForceColumn,
+	V3ClientOrderIDColumn,
+	V3FillQuantityColumn,
+	V3CommissionColumn,
+	V3ProceedsColumn,
+	V3StatusColumn,
+	V3CreatedAtColumn,
+	V3UpdatedAtColumn,
+	V3ClosedAtColumn,
+	V3OrderToCancelColumn,
+}
+
+var v3ColumnNamesSet map[string]struct{}
+
+func init() {
+	v3ColumnNamesSet = make(map[string]struct{})
+	for _, cn := range v3ColumnNames {
+		v3ColumnNamesSet[cn] = struct{}{}
+	}
+}
@@ -23,6 +23,7 @@ package bittrex
 import (
 	"context"
 	"encoding/csv"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -54,7 +55,7 @@ func (e *Extractor) Execute(ctx context.Context, db boil.ContextExecutor, reader
 	}
 
 	if config.Overwrite {
-		n, err := models.BittrexOrderHistories().DeleteAll(ctx, db)
+		n, err := models.BittrexOrderHistories(eupholio.InPortfolio(ctx), models.BittrexOrderHistoryWhere.Account.EQ(config.Account)).DeleteAll(ctx, db)
 		if err != nil {
 			return err
 		}
@@ -67,9 +68,12 @@ func (e *Extractor) Execute(ctx context.Context, db boil.ContextExecutor, reader
 	if err != nil {
 		return err
 	}
+	if err := config.HandleRowErrors(hrs.Errors); err != nil {
+		return err
+	}
 	trs := hrs.Orders
 	for _, tr := range trs {
-		err := tr.Insert(ctx, db, boil.Infer())
+		err := config.InsertRow(ctx, db, models.TableNames.BittrexOrderHistory, tr)
 		if err != nil {
 			if config.Debug {
 				log.Print(tr)
@@ -83,33 +87,41 @@ func (e *Extractor) Execute(ctx context.Context, db boil.ContextExecutor, reader
 
 type OrderHistory struct {
 	Orders models.BittrexOrderHistorySlice
+	Errors eupholio.RowErrors
 }
 
 // Extract extracts transactions from a reader
 func Extract(reader io.Reader) (*OrderHistory, error) {
-	rows, err := extractRecords(reader)
+	rows, errs, err := extractRecords(reader)
 	if err != nil {
 		return nil, err
 	}
 
 	var ohs models.BittrexOrderHistorySlice
 
-	for _, row := range rows {
+	for i, row := range rows {
+		if row == nil {
+			continue
+		}
 		timestamp, err := row.TimeStamp()
 		if err != nil {
-			return nil, err
+			errs = append(errs, eupholio.NewRowError(i+2, err))
+			continue
 		}
 		t := row.OrderType()
 		if t == OrderTypeUnknown {
-			return nil, err
+			errs = append(errs, &eupholio.RowError{Line: i + 2, Column: OrderTypeColumn, Err: fmt.Errorf("unknown order type %s", row.Get(OrderType))})
+			continue
 		}
 		closed, err := row.Closed()
 		if err != nil {
-			return nil, err
+			errs = append(errs, eupholio.NewRowError(i+2, err))
+			continue
 		}
 		tifID, err := row.TimeInForceTypeID()
 		if err != nil {
-			return nil, err
+			errs = append(errs, eupholio.NewRowError(i+2, err))
+			continue
 		}
 		oh := &models.BittrexOrderHistory{
 			UUID:              row.UUID(),
@@ -137,32 +149,40 @@ func Extract(reader io.Reader) (*OrderHistory, error) {
 
 	orderHistory := &OrderHistory{
 		Orders: ohs,
+		Errors: errs,
 	}
 	return orderHistory, nil
 }
 
-func extractRecords(reader io.Reader) ([]Record, error) {
+// extractRecords returns records aligned to rows of a reader, and errors of rows which cannot be read
+func extractRecords(reader io.Reader) ([]Record, eupholio.RowErrors, error) {
 	r := csv.NewReader(reader)
 
 	csvHead, err := r.Read()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
+	makeRecords := MakeRecords
 	if err := ValidateColumnNames(csvHead); err != nil {
-		return nil, err
+		if ValidateV3ColumnNames(csvHead) != nil {
+			return nil, nil, err
+		}
+		makeRecords = MakeV3Records
 	}
 
 	csvRows, err := r.ReadAll()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	rows, err := MakeRecords(csvHead, csvRows)
-	if err != nil {
-		return nil, err
+	rows, err := makeRecords(csvHead, csvRows)
+	if errs, ok := err.(eupholio.RowErrors); ok {
+		return rows, errs, nil
+	} else if err != nil {
+		return nil, nil, err
 	}
-	return rows, nil
+	return rows, nil, nil
 }
 
 // ValidateColumnNames checks columns
@@ -194,11 +214,22 @@ func NewDepositExtractor() *DepositExtractor {
 }
 
 func (e *DepositExtractor) Execute(ctx context.Context, db boil.ContextExecutor, reader io.Reader, options ...eupholio.Option) error {
+	config := &eupholio.Config{}
+	for _, o := range options {
+		o(config)
+	}
+
 	r := csv.NewReader(reader)
-	for {
+	var errs eupholio.RowErrors
+	for line := 1; ; line += depositOrWithdrawRows {
 		date, symbol, quantity, status, err := readDepositOrWithdrawCsv(r)
+		var rowErr *eupholio.RowError
 		if err == io.EOF {
 			break
+		} else if errors.As(err, &rowErr) {
+			rowErr.Line += line
+			errs = append(errs, rowErr)
+			continue
 		} else if err != nil {
 			return err
 		}
@@ -209,12 +240,12 @@ func (e *DepositExtractor) Execute(ctx context.Context, db boil.ContextExecutor,
 			Quantity:  types.NewDecimal(quantity),
 			Status:    status,
 		}
-		err = depost.Insert(ctx, db, boil.Infer())
+		err = config.InsertRow(ctx, db, models.TableNames.BittrexDepositHistory, depost)
 		if err != nil {
 			return err
 		}
 	}
-	return nil
+	return config.HandleRowErrors(errs)
 }
 
 // WithdrawExtractor loads withdraw history
@@ -222,11 +253,22 @@ type WithdrawExtractor struct {
 }
 
 func (e *WithdrawExtractor) Execute(ctx context.Context, db boil.ContextExecutor, reader io.Reader, options ...eupholio.Option) error {
+	config := &eupholio.Config{}
+	for _, o := range options {
+		o(config)
+	}
+
 	r := csv.NewReader(reader)
-	for {
+	var errs eupholio.RowErrors
+	for line := 1; ; line += depositOrWithdrawRows {
 		date, symbol, quantity, status, err := readDepositOrWithdrawCsv(r)
+		var rowErr *eupholio.RowError
 		if err == io.EOF {
 			break
+		} else if errors.As(err, &rowErr) {
+			rowErr.Line += line
+			errs = append(errs, rowErr)
+			continue
 		} else if err != nil {
 			return err
 		}
@@ -237,50 +279,51 @@ func (e *WithdrawExtractor) Execute(ctx context.Context, db boil.ContextExecutor
 			Quantity:  types.NewDecimal(quantity),
 			Status:    status,
 		}
-		err = depost.Insert(ctx, db, boil.Infer())
+		err = config.InsertRow(ctx, db, models.TableNames.BittrexWithdrawHistory, depost)
 		if err != nil {
 			return err
 		}
 	}
-	return nil
+	return config.HandleRowErrors(errs)
 }
 
 func NewWithdrawExtractor() *WithdrawExtractor {
 	return &WithdrawExtractor{}
 }
 
+// depositOrWithdrawRows is the number of rows of a deposit or a withdrawal
+const depositOrWithdrawRows = 4
+
+// readDepositOrWithdrawCsv reads rows of date, symbol, quantity and status.
+// An invalid value is returned as a RowError whose line is relative to the date row.
 func readDepositOrWithdrawCsv(r *csv.Reader) (date time.Time, symbol string, quantity *decimal.Big, status string, err error) {
-	timeRowFormat := "2006/01/02 15:04:05"
-	if timeRow, e := r.Read(); e != nil {
-		err = e
-		return
-	} else if date, err = time.Parse(timeRowFormat, timeRow[0]); err != nil {
-		return
+	var rows [depositOrWithdrawRows]string
+	for i := range rows {
+		row, e := r.Read()
+		if e != nil {
+			err = e
+			return
+		}
+		rows[i] = row[0]
 	}
 
-	if symbolRow, e := r.Read(); e != nil {
-		err = e
+	timeRowFormat := "2006/01/02 15:04:05"
+	if date, err = time.Parse(timeRowFormat, rows[0]); err != nil {
+		err = &eupholio.RowError{Line: 0, Err: err}
 		return
-	} else {
-		symbol = symbolRow[0]
 	}
 
+	symbol = rows[1]
+
 	var ok bool
-	if quantityRow, e := r.Read(); e != nil {
-		err = e
-		return
-	} else if quantity, ok = new(decimal.Big).SetString(quantityRow[0]); !ok {
-		err = fmt.Errorf("invalid quantity row: %v", quantityRow)
+	if quantity, ok = new(decimal.Big).SetString(rows[2]); !ok {
+		err = &eupholio.RowError{Line: 2, Err: fmt.Errorf("invalid quantity row: %v", rows[2])}
 		return
 	}
 
-	if statusRow, e := r.Read(); e != nil {
-		err = e
-	} else {
-		status = statusRow[0]
-		if status != "Completed" {
-			log.Println("unknown status:", status)
-		}
+	status = rows[3]
+	if status != "Completed" {
+		log.Println("unknown status:", status)
 	}
 	return
 }
@@ -0,0 +1,74 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package bittrex
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ericlagergren/decimal"
+)
+
+func TestExtractV3(t *testing.T) {
+	oh, err := Extract(strings.NewReader(testV3Csv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(oh.Orders) != 2 {
+		t.Fatalf("expected 2 orders but %d", len(oh.Orders))
+	}
+	o := oh.Orders[0]
+	if o.Exchange != "USDT-BTC" {
+		t.Errorf("unexpected exchange %s", o.Exchange)
+	}
+	if o.OrderType != OrderTypeMarketBuy {
+		t.Errorf("unexpected order type %d", o.OrderType)
+	}
+	if o.PricePerUnit.Big.Cmp(decimal.New(30000, 0)) != 0 {
+		t.Errorf("unexpected price per unit %s", o.PricePerUnit.Big.String())
+	}
+	if oh.Orders[1].OrderType != OrderTypeLimitSell {
+		t.Errorf("unexpected order type %d", oh.Orders[1].OrderType)
+	}
+}
+
+func TestExtractV3RowErrors(t *testing.T) {
+	csv := strings.Replace(testV3Csv, "ETH-BTC,SELL,LIMIT,1,0.03", "ETHBTC,SELL,LIMIT,1,0.03", 1)
+	oh, err := Extract(strings.NewReader(csv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(oh.Orders) != 1 {
+		t.Fatalf("expected 1 order but %d", len(oh.Orders))
+	}
+	if len(oh.Errors) != 1 {
+		t.Fatalf("expected 1 error but %d", len(oh.Errors))
+	}
+	if e := oh.Errors[0]; e.Line != 3 || e.Column != V3MarketSymbolColumn {
+		t.Errorf("unexpected error %s", e)
+	}
+}
+
+var testV3Csv = `Id,MarketSymbol,Direction,Type,Quantity,Limit,Ceiling,TimeInForce,ClientOrderId,FillQuantity,Commission,Proceeds,Status,CreatedAt,UpdatedAt,ClosedAt,OrderToCancel
+8f7c5a36-3e2a-4b2e-9d0e-2f1a3c4b5d6e,BTC-USDT,BUY,MARKET,0.01,,,IMMEDIATE_OR_CANCEL,,0.01,0.6,300,CLOSED,2021-01-02T03:04:05.123Z,2021-01-02T03:04:05.456Z,2021-01-02T03:04:05.456Z,
+1a2b3c4d-0000-4b2e-9d0e-2f1a3c4b5d6e,ETH-BTC,SELL,LIMIT,1,0.03,,GOOD_TIL_CANCELLED,,1,0.000075,0.03,CLOSED,2021-02-02T03:04:05Z,2021-02-03T03:04:05Z,2021-02-03T03:04:05Z,
+9a2b3c4d-0000-4b2e-9d0e-2f1a3c4b5d6e,ETH-BTC,SELL,LIMIT,1,0.05,,GOOD_TIL_CANCELLED,,0,0,0,CLOSED,2021-02-02T03:04:05Z,2021-02-03T03:04:05Z,2021-02-03T03:04:05Z,
+`
@@ -26,6 +26,8 @@ import (
 	"time"
 
 	"github.com/ericlagergren/decimal"
+
+	"github.com/eupholio/eupholio/pkg/eupholio"
 )
 
 const recordTimeFormat = "1/2/2006 3:04:05 PM"
@@ -45,8 +47,7 @@ func (r Record) Exchange() string {
 }
 
 func (r Record) TimeStamp() (time.Time, error) {
-	t := r.Get(TimeStamp)
-	return time.Parse(recordTimeFormat, t)
+	return r.parseTime(TimeStamp, TimeStampColumn)
 }
 
 func (r Record) OrderType() int {
@@ -55,6 +56,10 @@ func (r Record) OrderType() int {
 		return OrderTypeLimitSell
 	case LimitBuy:
 		return OrderTypeLimitBuy
+	case MarketSell:
+		return OrderTypeMarketSell
+	case MarketBuy:
+		return OrderTypeMarketBuy
 	}
 	return OrderTypeUnknown
 }
@@ -68,7 +73,7 @@ func (r Record) Quantity() *decimal.Big {
 }
 
 func (r Record) QuantityRemaining() *decimal.Big {
-	return r.parseNullDecimal(Quantity)
+	return r.parseNullDecimal(QuantityRemaining)
 }
 
 func (r Record) Commission() *decimal.Big {
@@ -102,18 +107,29 @@ func (r Record) ImmediateOrCancel() bool {
 }
 
 func (r Record) Closed() (time.Time, error) {
-	t := r.Get(Closed)
-	return time.Parse(recordTimeFormat, t)
+	return r.parseTime(Closed, ClosedColumn)
 }
 
 func (r Record) TimeInForceTypeID() (int, error) {
-	return strconv.Atoi(r.Get(TimeInForceTypeID))
+	id, err := strconv.Atoi(r.Get(TimeInForceTypeID))
+	if err != nil {
+		return 0, &eupholio.ColumnError{Column: TimeInForceTypeIDColumn, Err: err}
+	}
+	return id, nil
 }
 
 func (r Record) TimeInForce() string {
 	return r.Get(TimeInForce)
 }
 
+func (r Record) parseTime(id ColumnID, name string) (time.Time, error) {
+	t, err := time.Parse(recordTimeFormat, r.Get(id))
+	if err != nil {
+		return time.Time{}, &eupholio.ColumnError{Column: name, Err: err}
+	}
+	return t, nil
+}
+
 func (r Record) parseDecimal(id ColumnID) (*decimal.Big, bool) {
 	s := r.Get(id)
 	s = strings.ReplaceAll(s, ",", "")
@@ -0,0 +1,178 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package bittrex
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+// V3Record is a row of the order history exported after 2020
+type V3Record map[string]string
+
+func (r V3Record) Get(name string) string {
+	return r[name]
+}
+
+// Exchange converts a market symbol in base-quote order (ex. BTC-USDT)
+// to the classic quote-base notation (ex. USDT-BTC)
+func (r V3Record) Exchange() (string, error) {
+	ss := strings.Split(r.Get(V3MarketSymbolColumn), "-")
+	if len(ss) != 2 {
+		return "", &eupholio.ColumnError{Column: V3MarketSymbolColumn, Err: fmt.Errorf("invalid market symbol %s", r.Get(V3MarketSymbolColumn))}
+	}
+	return ss[1] + "-" + ss[0], nil
+}
+
+// OrderType converts direction and type to the classic order type
+func (r V3Record) OrderType() (string, error) {
+	var kind string
+	switch r.Get(V3TypeColumn) {
+	case "LIMIT", "CEILING_LIMIT":
+		kind = "LIMIT"
+	case "MARKET", "CEILING_MARKET":
+		kind = "MARKET"
+	default:
+		return "", &eupholio.ColumnError{Column: V3TypeColumn, Err: fmt.Errorf("unknown order type %s", r.Get(V3TypeColumn))}
+	}
+	switch r.Get(V3DirectionColumn) {
+	case "BUY", "SELL":
+		return kind + "_" + r.Get(V3DirectionColumn), nil
+	}
+	return "", &eupholio.ColumnError{Column: V3DirectionColumn, Err: fmt.Errorf("unknown direction %s", r.Get(V3DirectionColumn))}
+}
+
+func (r V3Record) Time(name string) (string, error) {
+	s := r.Get(name)
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		if t, err = time.Parse(recordTimeFormat, s); err != nil {
+			return "", &eupholio.ColumnError{Column: name, Err: err}
+		}
+	}
+	return t.UTC().Format(recordTimeFormat), nil
+}
+
+func (r V3Record) Decimal(name string) *decimal.Big {
+	if b, ok := new(decimal.Big).SetString(strings.ReplaceAll(r.Get(name), ",", "")); ok {
+		return b
+	}
+	return new(decimal.Big)
+}
+
+// Normalize converts a row to the classic layout, and returns false for orders never filled
+func (r V3Record) Normalize() (Record, bool, error) {
+	fill := r.Decimal(V3FillQuantityColumn)
+	if fill.Sign() == 0 {
+		return nil, false, nil
+	}
+	exchange, err := r.Exchange()
+	if err != nil {
+		return nil, false, err
+	}
+	orderType, err := r.OrderType()
+	if err != nil {
+		return nil, false, err
+	}
+	createdAt, err := r.Time(V3CreatedAtColumn)
+	if err != nil {
+		return nil, false, err
+	}
+	closedAt, err := r.Time(V3ClosedAtColumn)
+	if err != nil {
+		return nil, false, err
+	}
+	proceeds := r.Decimal(V3ProceedsColumn)
+	remaining := new(decimal.Big).Sub(r.Decimal(V3QuantityColumn), fill)
+	pricePerUnit := new(decimal.Big).Quo(proceeds, fill)
+	ioc := "False"
+	if r.Get(V3TimeInForceColumn) == "IMMEDIATE_OR_CANCEL" {
+		ioc = "True"
+	}
+
+	record := make(Record, len(columnNames))
+	record[UUID] = r.Get(V3IDColumn)
+	record[Exchange] = exchange
+	record[TimeStamp] = createdAt
+	record[OrderType] = orderType
+	record[Limit] = r.Decimal(V3LimitColumn).String()
+	record[Quantity] = fill.String()
+	record[QuantityRemaining] = remaining.String()
+	record[Commission] = r.Decimal(V3CommissionColumn).String()
+	record[Price] = proceeds.String()
+	record[PricePerUnit] = pricePerUnit.String()
+	record[IsConditional] = "False"
+	record[ImmediateOrCancel] = ioc
+	record[Closed] = closedAt
+	record[TimeInForceTypeID] = "0"
+	record[TimeInForce] = r.Get(V3TimeInForceColumn)
+	return record, true, nil
+}
+
+// ValidateV3ColumnNames checks columns of the order history exported after 2020
+func ValidateV3ColumnNames(names []string) error {
+	remains := make(map[string]struct{})
+	for k := range v3ColumnNamesSet {
+		remains[k] = struct{}{}
+	}
+	for _, n := range names {
+		if _, ok := v3ColumnNamesSet[n]; !ok {
+			return fmt.Errorf("unknown column %s found", n)
+		}
+		delete(remains, n)
+	}
+	if len(remains) > 0 {
+		for k := range remains {
+			return fmt.Errorf("column %s not found", k)
+		}
+	}
+	return nil
+}
+
+// MakeV3Records converts rows to records aligned to the rows.
+// Records are nil for orders never filled and rows which cannot be converted, which are returned as RowErrors.
+func MakeV3Records(head []string, rows [][]string) ([]Record, error) {
+	ret := make([]Record, 0, len(rows))
+	var errs eupholio.RowErrors
+	for n, row := range rows {
+		v3 := make(V3Record)
+		for i, col := range head {
+			v3[col] = row[i]
+		}
+		record, ok, err := v3.Normalize()
+		if err != nil {
+			errs = append(errs, eupholio.NewRowError(n+2, err))
+		}
+		if !ok {
+			record = nil
+		}
+		ret = append(ret, record)
+	}
+	if len(errs) > 0 {
+		return ret, errs
+	}
+	return ret, nil
+}
@@ -0,0 +1,82 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package bittrex
+
+import (
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/currency"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+// depositOrWithdrawMatch matches files of 4 rows per record without header, whose first row is a date
+func depositOrWithdrawMatch(head *eupholio.Head) bool {
+	if len(head.Header) != 1 {
+		return false
+	}
+	_, err := time.Parse("2006/01/02 15:04:05", head.Header[0])
+	return err == nil
+}
+
+func init() {
+	eupholio.RegisterExchange(&eupholio.Exchange{
+		Name:        "bittrex",
+		DisplayName: "bittrex",
+		FileTypes: []*eupholio.FileType{
+			{
+				Name: "order",
+				Match: func(head *eupholio.Head) bool {
+					return eupholio.CSVMatch(ValidateColumnNames)(head) || eupholio.CSVMatch(ValidateV3ColumnNames)(head)
+				},
+				Extractor: func(*time.Location) eupholio.Extractor { return NewExtractor() },
+			},
+			{
+				Name:      "deposit",
+				Hints:     []string{"deposit"},
+				Match:     depositOrWithdrawMatch,
+				Extractor: func(*time.Location) eupholio.Extractor { return NewDepositExtractor() },
+			},
+			{
+				Name:      "withdraw",
+				Hints:     []string{"withdraw"},
+				Match:     depositOrWithdrawMatch,
+				Extractor: func(*time.Location) eupholio.Extractor { return NewWithdrawExtractor() },
+			},
+		},
+		DefaultFileType: "order",
+		Translator: func(db boil.ContextExecutor, fiat currency.Symbol) eupholio.Translator {
+			return NewTranslator(NewRepository(db), fiat)
+		},
+		RawTables: []eupholio.RawTable{
+			{Name: models.TableNames.BittrexOrderHistory, TimeColumn: "timestamp", KeyColumns: []string{"uuid"}},
+			{Name: models.TableNames.BittrexDepositHistory, TimeColumn: "timestamp"},
+			{Name: models.TableNames.BittrexWithdrawHistory, TimeColumn: "timestamp"},
+		},
+		WalletCodes: map[string]string{
+			WalletCode:        "BT",
+			WalletCode + "_W": "BTw",
+			WalletCode + "_D": "BTd",
+		},
+	})
+}
@@ -30,9 +30,10 @@ import (
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 
 	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/eupholio"
 )
 
-const timeFormat = "2006/01/02 15:04:05"
+const timeFormat = "2006-01-02 15:04:05"
 
 type Repository interface {
 	FindOrderHistories(ctx context.Context, start, end time.Time) (models.BittrexOrderHistorySlice, error)
@@ -51,9 +52,10 @@ type repository struct {
 }
 
 func (r *repository) FindOrderHistories(ctx context.Context, start, end time.Time) (models.BittrexOrderHistorySlice, error) {
-	s := start.Format(timeFormat)
-	e := end.Format(timeFormat)
+	s := start.UTC().Format(timeFormat)
+	e := end.UTC().Format(timeFormat)
 	trs, err := models.BittrexOrderHistories(
+		eupholio.InPortfolio(ctx),
 		qm.Where("timestamp >= ? AND timestamp < ?", s, e),
 		qm.OrderBy("timestamp ASC"),
 	).All(ctx, r.db)
@@ -65,9 +67,10 @@ func (r *repository) FindOrderHistories(ctx context.Context, start, end time.Tim
 }
 
 func (r *repository) FindDepositHistories(ctx context.Context, start, end time.Time) (models.BittrexDepositHistorySlice, error) {
-	s := start.Format(timeFormat)
-	e := end.Format(timeFormat)
+	s := start.UTC().Format(timeFormat)
+	e := end.UTC().Format(timeFormat)
 	dhs, err := models.BittrexDepositHistories(
+		eupholio.InPortfolio(ctx),
 		qm.Where("timestamp >= ? AND timestamp < ?", s, e),
 		qm.OrderBy("timestamp ASC"),
 	).All(ctx, r.db)
@@ -79,9 +82,10 @@ func (r *repository) FindDepositHistories(ctx context.Context, start, end time.T
 }
 
 func (r *repository) FindWithdrawHistories(ctx context.Context, start, end time.Time) (models.BittrexWithdrawHistorySlice, error) {
-	s := start.Format(timeFormat)
-	e := end.Format(timeFormat)
+	s := start.UTC().Format(timeFormat)
+	e := end.UTC().Format(timeFormat)
 	whs, err := models.BittrexWithdrawHistories(
+		eupholio.InPortfolio(ctx),
 		qm.Where("timestamp >= ? AND timestamp < ?", s, e),
 		qm.OrderBy("timestamp ASC"),
 	).All(ctx, r.db)
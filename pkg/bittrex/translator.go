@@ -28,7 +28,6 @@ import (
 	"time"
 
 	"github.com/ericlagergren/decimal"
-	"github.com/volatiletech/sqlboiler/v4/boil"
 
 	"github.com/eupholio/eupholio/models"
 	"github.com/eupholio/eupholio/pkg/currency"
@@ -37,12 +36,14 @@ import (
 
 // Translator is a translator for BitTrex
 type Translator struct {
+	repository   Repository
 	baseCurrency currency.Symbol
 }
 
 // NewTranslator create a translator for BitTrex
-func NewTranslator(baseCurrency currency.Symbol) *Translator {
+func NewTranslator(repo Repository, baseCurrency currency.Symbol) *Translator {
 	return &Translator{
+		repository:   repo,
 		baseCurrency: baseCurrency,
 	}
 }
@@ -53,14 +54,16 @@ func (t *Translator) Translate(ctx context.Context, repository eupholio.Reposito
 	s := start.Format(timeFormat)
 	e := end.Format(timeFormat)
 
-	bittrexRepository := NewRepository(repository)
+	bittrexRepository := t.repository
 
-	n, err := repository.DeleteTransaction(ctx, WalletCode, start, end)
-	if err != nil {
-		return err
-	}
-	if n > 0 {
-		log.Println("deleted", n, "entries from events")
+	for _, walletCode := range []string{WalletCode, WalletCode + "_D", WalletCode + "_W"} {
+		n, err := repository.DeleteTransaction(ctx, walletCode, start, end)
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			log.Println("deleted", n, "entries from events")
+		}
 	}
 
 	trs, err := bittrexRepository.FindOrderHistories(ctx, start, end)
@@ -74,7 +77,7 @@ func (t *Translator) Translate(ctx context.Context, repository eupholio.Reposito
 	var events []*models.Event
 
 	for _, tr := range trs {
-		transaction, err := repository.CreateTransaction(ctx, tr.Timestamp, WalletCode, tr.ID)
+		transaction, err := repository.CreateTransaction(ctx, tr.Timestamp, WalletCode, tr.Account, tr.ID)
 		if err != nil {
 			return err
 		}
@@ -84,7 +87,7 @@ func (t *Translator) Translate(ctx context.Context, repository eupholio.Reposito
 			return err
 		}
 		transaction.Description = desc
-		if _, err := transaction.Update(ctx, repository, boil.Infer()); err != nil {
+		if err := repository.UpdateTransaction(ctx, transaction); err != nil {
 			return err
 		}
 
@@ -100,7 +103,7 @@ func (t *Translator) Translate(ctx context.Context, repository eupholio.Reposito
 	}
 
 	for _, d := range dhs {
-		transaction, err := repository.CreateTransaction(ctx, d.Timestamp, WalletCode+"_D", d.ID)
+		transaction, err := repository.CreateTransaction(ctx, d.Timestamp, WalletCode+"_D", d.Account, d.ID)
 		if err != nil {
 			return err
 		}
@@ -118,7 +121,7 @@ func (t *Translator) Translate(ctx context.Context, repository eupholio.Reposito
 	}
 
 	for _, w := range whs {
-		transaction, err := repository.CreateTransaction(ctx, w.Timestamp, WalletCode+"_W", w.ID)
+		transaction, err := repository.CreateTransaction(ctx, w.Timestamp, WalletCode+"_W", w.Account, w.ID)
 		if err != nil {
 			return err
 		}
@@ -150,7 +153,7 @@ func (t *Translator) translateTransaction(ctx context.Context, repository euphol
 	desc := ""
 	var events []*models.Event
 	switch tr.OrderType {
-	case OrderTypeLimitBuy:
+	case OrderTypeLimitBuy, OrderTypeMarketBuy:
 		trading := tradingQuantity                          // total quantity of trading currency you get
 		payment := add(paymentQuantity, commissionQuantity) // total quantity of payment currency you loose
 		cost := add(paymentQuantity, commissionQuantity)    // payment currency you loose
@@ -161,7 +164,7 @@ func (t *Translator) translateTransaction(ctx context.Context, repository euphol
 		commission := newEvent(eupholio.EventTypeCommission, paymentCurrency, fee, paymentCurrency, fee) // commission
 		events = append(events, sell, buy, commission)
 		desc = fmt.Sprintf("buy %s/%s", tradingCurrency, paymentCurrency)
-	case OrderTypeLimitSell:
+	case OrderTypeLimitSell, OrderTypeMarketSell:
 		trading := tradingQuantity                          // total quantity of trading currency you loose
 		payment := sub(paymentQuantity, commissionQuantity) // total quantity of payment currency you get
 		cost := paymentQuantity                             // payment currency equivalent to trading currency you loose
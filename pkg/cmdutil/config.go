@@ -0,0 +1,53 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package cmdutil
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/eupholio/eupholio/pkg/appconfig"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+// AddConfigFlag adds the --config flag to a root command and its subcommands
+func AddConfigFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().String("config", "", "config file ($EUPHOLIO_CONFIG, or $XDG_CONFIG_HOME/eupholio/config.yaml if empty)")
+}
+
+// Config loads the config selected by the --config flag of a command
+func Config(cmd *cobra.Command) (*appconfig.Config, error) {
+	path, err := cmd.Flags().GetString("config")
+	if err != nil {
+		return nil, err
+	}
+	return appconfig.Load(path)
+}
+
+// Context returns a context which carries the defaults of the config of a command
+func Context(cmd *cobra.Command) (context.Context, error) {
+	config, err := Config(cmd)
+	if err != nil {
+		return nil, err
+	}
+	return eupholio.WithDefaults(context.Background(), config.Defaults()), nil
+}
@@ -23,15 +23,39 @@ package cmdutil
 import (
 	"context"
 	"database/sql"
+
+	"github.com/spf13/cobra"
+
+	"github.com/eupholio/eupholio/pkg/appconfig"
+	"github.com/eupholio/eupholio/pkg/sqlite"
 )
 
-// OpenDB opens a database with default settings
-func OpenDB() (*sql.DB, error) {
-	db, err := sql.Open("mysql", "eupholio:eupholio@tcp(localhost)/eupholio?parseTime=true")
+// Database drivers selected by the config
+const (
+	DriverMySQL  = appconfig.DriverMySQL
+	DriverSQLite = appconfig.DriverSQLite
+)
+
+// DBDriver returns the database driver of the config of a command
+func DBDriver(cmd *cobra.Command) (string, error) {
+	config, err := Config(cmd)
+	if err != nil {
+		return "", err
+	}
+	return config.Database.Driver, nil
+}
+
+// OpenDB opens the database of the config of a command
+func OpenDB(cmd *cobra.Command) (*sql.DB, error) {
+	config, err := Config(cmd)
 	if err != nil {
 		return nil, err
 	}
-	return db, nil
+	database := config.Database
+	if database.Driver == DriverSQLite {
+		return sqlite.Open(database.DSN)
+	}
+	return sql.Open("mysql", database.DSN)
 }
 
 // WithTx runs fn with a transaction
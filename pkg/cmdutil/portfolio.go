@@ -0,0 +1,70 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package cmdutil
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+// AddPortfolioFlag adds the --portfolio flag to a root command and its subcommands
+func AddPortfolioFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().String("portfolio", "", "portfolio (the default portfolio if empty)")
+}
+
+// PortfolioContext returns a context scoped to the portfolio selected by the --portfolio flag, which carries the defaults of the config
+func PortfolioContext(cmd *cobra.Command, db boil.ContextExecutor) (context.Context, *models.Portfolio, error) {
+	name, err := cmd.Flags().GetString("portfolio")
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx, err := Context(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+	portfolio, err := eupholio.FindPortfolio(ctx, db, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	return eupholio.WithPortfolio(ctx, portfolio), portfolio, nil
+}
+
+// FiatAndLocation returns the --fiat flag, or the fiat of a portfolio if it is empty, and the timezone of a portfolio
+func FiatAndLocation(cmd *cobra.Command, portfolio *models.Portfolio) (string, *time.Location, error) {
+	fiat, err := cmd.Flags().GetString("fiat")
+	if err != nil {
+		return "", nil, err
+	}
+	if fiat == "" {
+		fiat = portfolio.Fiat
+	}
+	loc, err := eupholio.PortfolioLocation(portfolio)
+	if err != nil {
+		return "", nil, err
+	}
+	return fiat, loc, nil
+}
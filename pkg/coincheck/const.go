@@ -32,8 +32,29 @@ const (
 	OperationSent                      = "Sent"
 	OperationBankWithdrawal            = "Bank Withdrawal"
 	OperationCancelLimitOrder          = "Cancel Limit Order"
+	OperationBankDeposit               = "Bank Deposit"
+	OperationSendingFee                = "Sending Fee"
+	OperationLend                      = "Lend"
+	OperationLendingReturn             = "Lending Return"
+	OperationLendingInterest           = "Lending Interest"
 )
 
+// operationNames maps operation names of the 取引履歴 export to the legacy ones
+var operationNames = map[string]string{
+	"受取":        OperationReceived,
+	"預入":        OperationReceived,
+	"指値注文":      OperationLimitOrder,
+	"約定":        OperationCompletedTradingContracts,
+	"送金":        OperationSent,
+	"出金":        OperationBankWithdrawal,
+	"指値注文キャンセル": OperationCancelLimitOrder,
+	"入金":        OperationBankDeposit,
+	"送金手数料":     OperationSendingFee,
+	"貸暗号資産 貸出":  OperationLend,
+	"貸暗号資産 返却":  OperationLendingReturn,
+	"貸暗号資産 利用料": OperationLendingInterest,
+}
+
 type ColumnID int
 
 // Column Index
@@ -47,6 +68,14 @@ const (
 	OriginalCurrency
 	Fee
 	Comment
+	Pair
+	numOfColumns
+)
+
+// Formats
+const (
+	FormatLegacy = "legacy"
+	FormatNew    = "new"
 )
 
 const (
@@ -61,23 +90,28 @@ const (
 	CommentColumn          = "comment"
 )
 
-var columnNames = []string{
-	IDColumn,
-	TimeColumn,
-	OperationColumn,
-	AmountColumn,
-	TradingCurrencyColumn,
-	PriceColumn,
-	OriginalCurrencyColumn,
-	FeeColumn,
-	CommentColumn,
-}
-
-var columnNamesSet map[string]ColumnID
-
-func init() {
-	columnNamesSet = make(map[string]ColumnID)
-	for i, cn := range columnNames {
-		columnNamesSet[cn] = ColumnID(i)
-	}
+var columnNames = map[string]map[string]ColumnID{
+	FormatLegacy: {
+		IDColumn:               ID,
+		TimeColumn:             Time,
+		OperationColumn:        Operation,
+		AmountColumn:           Amount,
+		TradingCurrencyColumn:  TradingCurrency,
+		PriceColumn:            Price,
+		OriginalCurrencyColumn: OriginalCurrency,
+		FeeColumn:              Fee,
+		CommentColumn:          Comment,
+	},
+	// 取引履歴
+	FormatNew: {
+		"ID":   ID,
+		"日時":   Time,
+		"取引種別": Operation,
+		"数量":   Amount,
+		"通貨":   TradingCurrency,
+		"レート":  Price,
+		"通貨ペア": Pair,
+		"手数料":  Fee,
+		"備考":   Comment,
+	},
 }
@@ -21,6 +21,7 @@
 package coincheck
 
 import (
+	"bufio"
 	"context"
 	"encoding/csv"
 	"fmt"
@@ -52,10 +53,8 @@ func (e *Extractor) Execute(ctx context.Context, db boil.ContextExecutor, reader
 		o(config)
 	}
 
-	repository := NewRepository(db)
-
 	if config.Overwrite {
-		n, err := models.CoincheckHistories().DeleteAll(ctx, db)
+		n, err := models.CoincheckHistories(eupholio.InPortfolio(ctx), models.CoincheckHistoryWhere.Account.EQ(config.Account)).DeleteAll(ctx, db)
 		if err != nil {
 			return err
 		}
@@ -68,21 +67,27 @@ func (e *Extractor) Execute(ctx context.Context, db boil.ContextExecutor, reader
 	if err != nil {
 		return err
 	}
-	err = repository.CreateHistories(ctx, hrs.Entries)
-	if err != nil {
+	if err := config.HandleRowErrors(hrs.Errors); err != nil {
 		return err
 	}
+	for _, h := range hrs.Entries {
+		err := config.InsertRow(ctx, db, models.TableNames.CoincheckHistory, h)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 // ValidateColumnNames checks columns
-func ValidateColumnNames(names []string) error {
+func ValidateColumnNames(format string, names []string) error {
 	remains := make(map[string]struct{})
-	for k := range columnNamesSet {
+	cnSet := columnNames[format]
+	for k := range cnSet {
 		remains[k] = struct{}{}
 	}
 	for _, n := range names {
-		if _, ok := columnNamesSet[n]; !ok {
+		if _, ok := cnSet[n]; !ok {
 			return fmt.Errorf("unknown column %s found", n)
 		}
 		delete(remains, n)
@@ -97,6 +102,7 @@ func ValidateColumnNames(names []string) error {
 
 type History struct {
 	Entries models.CoincheckHistorySlice
+	Errors  eupholio.RowErrors
 }
 
 func ExtractFromFile(path string) (*History, error) {
@@ -115,11 +121,17 @@ func Extract(reader io.Reader) (*History, error) {
 	}
 
 	var hs models.CoincheckHistorySlice
+	var errs eupholio.RowErrors
 
-	for _, row := range rows {
+	for i, row := range rows {
 		tm, err := row.Time()
 		if err != nil {
-			return nil, err
+			errs = append(errs, &eupholio.RowError{Line: i + 2, Column: TimeColumn, Err: err})
+			continue
+		}
+		if row.Amount() == nil {
+			errs = append(errs, &eupholio.RowError{Line: i + 2, Column: AmountColumn, Err: fmt.Errorf("invalid decimal %s", row.Get(Amount))})
+			continue
 		}
 		op := row.Operation()
 		oc := null.NewString(row.OriginalCurrency(), len(row.OriginalCurrency()) > 0)
@@ -133,25 +145,36 @@ func Extract(reader io.Reader) (*History, error) {
 			OriginalCurrency: oc,
 			Fee:              types.NewNullDecimal(row.Fee()),
 			Comment:          row.Comment(),
+			Pair:             null.NewString(row.Pair(), len(row.Pair()) > 0),
 		}
 		hs = append(hs, h)
 	}
 
 	trhistory := &History{
 		Entries: hs,
+		Errors:  errs,
 	}
 	return trhistory, nil
 }
 
 func extractRecords(reader io.Reader) ([]Record, error) {
-	r := csv.NewReader(reader)
+	r := csv.NewReader(skipBOM(reader)) // 取引履歴 is exported with BOM
 
 	csvHead, err := r.Read()
 	if err != nil {
 		return nil, err
 	}
 
-	if err := ValidateColumnNames(csvHead); err != nil {
+	format := ""
+	for _, f := range []string{FormatLegacy, FormatNew} {
+		err = ValidateColumnNames(f, csvHead)
+		if err == nil {
+			format = f
+			break
+		}
+	}
+
+	if err != nil {
 		return nil, err
 	}
 
@@ -160,9 +183,17 @@ func extractRecords(reader io.Reader) ([]Record, error) {
 		return nil, err
 	}
 
-	rows, err := MakeRecords(csvHead, csvRows)
+	rows, err := MakeRecords(format, csvHead, csvRows)
 	if err != nil {
 		return nil, err
 	}
 	return rows, nil
 }
+
+func skipBOM(r io.Reader) io.Reader {
+	buf := bufio.NewReader(r)
+	if b, err := buf.Peek(3); err == nil && b[0] == 0xef && b[1] == 0xbb && b[2] == 0xbf {
+		buf.Discard(3)
+	}
+	return buf
+}
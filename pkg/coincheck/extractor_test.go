@@ -0,0 +1,57 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package coincheck
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExtract(t *testing.T) {
+	for name, csv := range map[string]string{
+		FormatLegacy: testLegacyCsv,
+		FormatNew:    testNewCsv,
+	} {
+		h, err := Extract(strings.NewReader(csv))
+		if err != nil {
+			t.Fatal(name, err)
+		}
+		if len(h.Entries) != 2 {
+			t.Fatalf("%s: expected 2 entries but %d", name, len(h.Entries))
+		}
+		if h.Entries[0].Operation != OperationCompletedTradingContracts {
+			t.Errorf("%s: unexpected operation %s", name, h.Entries[0].Operation)
+		}
+		if h.Entries[1].Operation != OperationLendingInterest && h.Entries[1].Operation != OperationReceived {
+			t.Errorf("%s: unexpected operation %s", name, h.Entries[1].Operation)
+		}
+	}
+}
+
+var testLegacyCsv = `id,time,operation,amount,trading_currency,price,original_currency,fee,comment
+100,2017-12-01 10:00:00 +0900,Completed trading contracts,0.01,BTC,1200000.0,JPY,,"Rate: 1200000.0, Pair: btc_jpy"
+101,2017-12-02 10:00:00 +0900,Received,0.5,ETH,,,,
+`
+
+var testNewCsv = "\ufeff" + `"ID","日時","取引種別","数量","通貨","レート","通貨ペア","手数料","備考"
+"200","2023/01/05 12:00:00","約定","0.01","BTC","2,300,000","btc_jpy","",""
+"201","2023/02/01 00:00:00","貸暗号資産 利用料","0.0001","BTC","","","",""
+`
@@ -28,7 +28,10 @@ import (
 	"github.com/ericlagergren/decimal"
 )
 
-const recordTimeFormat = "2006-01-02 15:04:05 +0900"
+const (
+	recordTimeFormat    = "2006-01-02 15:04:05 +0900"
+	newRecordTimeFormat = "2006/01/02 15:04:05"
+)
 
 type Record []string
 
@@ -43,11 +46,18 @@ func (r Record) ID() string {
 func (r Record) Time() (time.Time, error) {
 	jst, _ := time.LoadLocation("Asia/Tokyo")
 	t := r.Get(Time)
-	return time.ParseInLocation(recordTimeFormat, t, jst)
+	if tm, err := time.ParseInLocation(recordTimeFormat, t, jst); err == nil {
+		return tm, nil
+	}
+	return time.ParseInLocation(newRecordTimeFormat, t, jst)
 }
 
 func (r Record) Operation() string {
-	return r.Get(Operation)
+	op := r.Get(Operation)
+	if name, ok := operationNames[op]; ok {
+		return name
+	}
+	return op
 }
 
 func (r Record) Amount() *decimal.Big {
@@ -74,6 +84,10 @@ func (r Record) Comment() string {
 	return r.Get(Comment)
 }
 
+func (r Record) Pair() string {
+	return r.Get(Pair)
+}
+
 func (r Record) parseDecimal(id ColumnID) (*decimal.Big, bool) {
 	s := r.Get(id)
 	s = strings.ReplaceAll(s, ",", "")
@@ -89,15 +103,15 @@ func (r Record) parseNullDecimal(id ColumnID) *decimal.Big {
 	return b
 }
 
-func MakeRecords(head []string, rows [][]string) ([]Record, error) {
+func MakeRecords(format string, head []string, rows [][]string) ([]Record, error) {
 	m := make(map[int]ColumnID)
 	for i, k := range head {
-		columnID := columnNamesSet[k]
+		columnID := columnNames[format][k]
 		m[i] = columnID
 	}
 	var ret []Record
 	for _, row := range rows {
-		sorted := make([]string, len(columnNames))
+		sorted := make([]string, numOfColumns)
 		for i, col := range row {
 			columnID := m[i]
 			sorted[columnID] = col
@@ -109,18 +123,18 @@ func MakeRecords(head []string, rows [][]string) ([]Record, error) {
 
 var limitOrderRe = regexp.MustCompile(`Rate: ([0-9]+\.[0-9]+), Pair: ([0-9a-z]+)_([0-9a-z]+)`)
 
-func parseLimitOrder(comment string) (rate *decimal.Big, quote, base string, ok bool) {
+func parseLimitOrder(comment string) (rate *decimal.Big, trading, payment string, ok bool) {
 	matched := limitOrderRe.FindAllStringSubmatch(comment, -1)
 	if len(matched) == 1 {
 		m := matched[0]
 		rate, ok = new(decimal.Big).SetString(m[1])
-		quote = strings.ToUpper(m[2])
-		base = strings.ToUpper(m[3])
+		trading = strings.ToUpper(m[2])
+		payment = strings.ToUpper(m[3])
 	}
 	return
 }
 
-func parseCompletedTradingContracts(comment string) (rate *decimal.Big, quote, base string, ok bool) {
+func parseCompletedTradingContracts(comment string) (rate *decimal.Big, trading, payment string, ok bool) {
 	return parseLimitOrder(comment)
 }
 
@@ -135,3 +149,14 @@ func parseSent(comment string) (address string, ok bool) {
 	}
 	return
 }
+
+// parsePair parses a currency pair of 取引履歴 (ex. btc_jpy) into the trading and payment currencies
+func parsePair(pair string) (trading, payment string, ok bool) {
+	cs := strings.Split(pair, "_")
+	if len(cs) == 2 {
+		trading = strings.ToUpper(cs[0])
+		payment = strings.ToUpper(cs[1])
+		ok = true
+	}
+	return
+}
@@ -30,9 +30,10 @@ import (
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 
 	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/eupholio"
 )
 
-const timeFormat = "2006/01/02 15:04:05"
+const timeFormat = "2006-01-02 15:04:05"
 
 type Repository interface {
 	FindHistories(ctx context.Context, start, end time.Time) (models.CoincheckHistorySlice, error)
@@ -54,6 +55,7 @@ func (r *repository) FindHistories(ctx context.Context, start time.Time, end tim
 	s := start.UTC().Format(timeFormat)
 	e := end.UTC().Format(timeFormat)
 	q := models.CoincheckHistories(
+		eupholio.InPortfolio(ctx),
 		qm.Where("time >= ? AND time < ?", s, e),
 		qm.OrderBy("time ASC"),
 	)
@@ -73,6 +75,7 @@ func (r *repository) FindHistoriesByYear(ctx context.Context, year int, loc *tim
 
 func (r *repository) CreateHistories(ctx context.Context, trs models.CoincheckHistorySlice) error {
 	for _, tr := range trs {
+		tr.PortfolioID = eupholio.PortfolioID(ctx)
 		err := tr.Insert(ctx, r.db, boil.Infer())
 		if err != nil {
 			return err
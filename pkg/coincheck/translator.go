@@ -27,17 +27,24 @@ import (
 	"time"
 
 	"github.com/ericlagergren/decimal"
-	"github.com/volatiletech/sqlboiler/v4/boil"
 
 	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/currency"
 	"github.com/eupholio/eupholio/pkg/eupholio"
 )
 
 type Translator struct {
+	repository Repository
+	fiat       currency.Symbol
 }
 
-func NewTranslator() *Translator {
-	return &Translator{}
+// NewTranslator creates a translator for coincheck. Movements without cost are valued
+// in the fiat of the portfolio, and fees paid in JPY are converted to it
+func NewTranslator(repo Repository, fiat currency.Symbol) *Translator {
+	return &Translator{
+		repository: repo,
+		fiat:       fiat,
+	}
 }
 
 // Translate stores extracted transaction data to transaction table
@@ -46,7 +53,7 @@ func (t *Translator) Translate(ctx context.Context, repo eupholio.Repository, st
 	s := start.Format(tf)
 	e := end.Format(tf)
 
-	coincheckRepository := NewRepository(repo)
+	coincheckRepository := t.repository
 
 	n, err := repo.DeleteTransaction(ctx, WalletCode, start, end)
 	if err != nil {
@@ -65,7 +72,7 @@ func (t *Translator) Translate(ctx context.Context, repo eupholio.Repository, st
 	}
 
 	for _, tr := range trs {
-		transaction, err := repo.CreateTransaction(ctx, tr.Time, WalletCode, tr.ID)
+		transaction, err := repo.CreateTransaction(ctx, tr.Time, WalletCode, tr.Account, tr.ID)
 		if err != nil {
 			return err
 		}
@@ -75,7 +82,7 @@ func (t *Translator) Translate(ctx context.Context, repo eupholio.Repository, st
 		}
 		if len(desc) > 0 {
 			transaction.Description = desc
-			_, err = transaction.Update(ctx, repo, boil.Infer())
+			err = repo.UpdateTransaction(ctx, transaction)
 			if err != nil {
 				return err
 			}
@@ -99,73 +106,113 @@ func (t *Translator) translateTransaction(transaction *models.Transaction, tr *m
 
 	targetCurrency := tr.TradingCurrency
 	targetQuantity := tr.Amount.Big
-	feeQuantity := tr.Fee.Big // ?
-	fiat := FiatCode
+	feeQuantity := zero
+	if tr.Fee.Big != nil {
+		feeQuantity = tr.Fee.Big
+	}
+	fiat := t.fiat.String()
 
 	switch tr.Operation {
 	case OperationLimitOrder:
+		desc = "limit order"
+	case OperationCancelLimitOrder:
+		desc = "cancel limit order"
 	case OperationCompletedTradingContracts:
 		rate, tradingCurrency, paymentCurrency, ok := parseCompletedTradingContracts(tr.Comment) // rate = payment / trading
+		if !ok && tr.Pair.Valid && tr.Price.Big != nil {
+			tradingCurrency, paymentCurrency, ok = parsePair(tr.Pair.String) // 取引履歴
+			rate = tr.Price.Big
+		}
 		if !ok {
 			return nil, "", fmt.Errorf("failed to parse: %s", tr.Comment)
 		}
+		fee := abs(feeQuantity) // fee is deducted from the target currency
 		switch targetCurrency {
 		case tradingCurrency: // buy BTC (ex. BTC-JPY)
-			if feeQuantity != nil && feeQuantity.Sign() > 0 {
-				return nil, "", fmt.Errorf("fee is not supported yet")
-			} else {
-				trading := targetQuantity
-				payment := mul(rate, targetQuantity)
-				cost := mul(rate, targetQuantity)
-				buy := newEvent(eupholio.EventTypeBuy, tradingCurrency, trading, paymentCurrency, cost)
-				sell := newEvent(eupholio.EventTypeSell, paymentCurrency, payment, paymentCurrency, cost)
-				events = append(events, buy, sell)
-				desc = fmt.Sprintf("buy %s/%s", tradingCurrency, paymentCurrency)
+			trading := sub(targetQuantity, fee)
+			payment := mul(rate, targetQuantity)
+			cost := mul(rate, targetQuantity)
+			buy := newEvent(eupholio.EventTypeBuy, tradingCurrency, trading, paymentCurrency, cost)
+			sell := newEvent(eupholio.EventTypeSell, paymentCurrency, payment, paymentCurrency, cost)
+			events = append(events, buy, sell)
+			if fee.Sign() != 0 {
+				commission := newEvent(eupholio.EventTypeCommission, tradingCurrency, fee, paymentCurrency, mul(rate, fee))
+				events = append(events, commission)
 			}
+			desc = fmt.Sprintf("buy %s/%s", tradingCurrency, paymentCurrency)
 		case paymentCurrency: // buy JPY (ex. BTC-JPY)
-			if feeQuantity != nil && feeQuantity.Sign() > 0 {
-				return nil, "", fmt.Errorf("fee is not supported yet")
-			} else {
-				trading := new(decimal.Big).Quo(targetQuantity, rate)
-				payment := targetQuantity // JPY
-				cost := payment
-				sell := newEvent(eupholio.EventTypeSell, tradingCurrency, trading, paymentCurrency, cost)
-				buy := newEvent(eupholio.EventTypeBuy, paymentCurrency, payment, paymentCurrency, cost)
-				events = append(events, sell, buy)
-				desc = fmt.Sprintf("sell %s/%s", tradingCurrency, paymentCurrency)
+			trading := new(decimal.Big).Quo(targetQuantity, rate)
+			payment := sub(targetQuantity, fee) // JPY
+			cost := targetQuantity
+			sell := newEvent(eupholio.EventTypeSell, tradingCurrency, trading, paymentCurrency, cost)
+			buy := newEvent(eupholio.EventTypeBuy, paymentCurrency, payment, paymentCurrency, cost)
+			events = append(events, sell, buy)
+			if fee.Sign() != 0 {
+				commission := newEvent(eupholio.EventTypeCommission, paymentCurrency, fee, paymentCurrency, fee)
+				events = append(events, commission)
 			}
+			desc = fmt.Sprintf("sell %s/%s", tradingCurrency, paymentCurrency)
 		default:
 			return nil, "", fmt.Errorf("invalid trading currency %s for %s-%s", targetCurrency, tradingCurrency, paymentCurrency)
 		}
+		if fee.Sign() != 0 {
+			desc = desc + fmt.Sprintf(" w/ %s", toString(abs(fee), targetCurrency))
+		}
 	case OperationReceived:
-		deposit := newEvent(eupholio.EventTypeDeposit, targetCurrency, targetQuantity, fiat, zero)
+		deposit := newEvent(eupholio.EventTypeDeposit, targetCurrency, abs(targetQuantity), fiat, zero)
+		events = append(events, deposit)
+		desc = fmt.Sprintf("received %s", toString(abs(targetQuantity), targetCurrency))
+	case OperationBankDeposit:
+		deposit := newEvent(eupholio.EventTypeDeposit, targetCurrency, abs(targetQuantity), fiat, zero)
 		events = append(events, deposit)
-		desc = fmt.Sprintf("received %s", toString(targetQuantity, targetCurrency))
+		desc = fmt.Sprintf("deposit %s from bank", targetCurrency)
 	case OperationSent:
 		address, ok := parseSent(tr.Comment)
 		withdraw := newEvent(eupholio.EventTypeWithdraw, targetCurrency, neg(sub(targetQuantity, feeQuantity)), fiat, zero)
 		events = append(events, withdraw)
-		if feeQuantity != nil && feeQuantity.Sign() != 0 {
-			fee := newEvent(eupholio.EventTypeFee, targetCurrency, neg(feeQuantity), fiat, zero)
+		if feeQuantity.Sign() != 0 {
+			f := abs(feeQuantity)
+			fee := newEvent(eupholio.EventTypeFee, targetCurrency, f, targetCurrency, f) // valued at the market price
 			events = append(events, fee)
 		}
-		if ok {
+		if ok && len(address) >= 7 {
 			desc = fmt.Sprintf("sent %s to %s", targetCurrency, address[0:7])
 		} else {
 			desc = fmt.Sprintf("sent %s", targetCurrency)
 		}
-		if feeQuantity != nil && feeQuantity.Sign() != 0 {
-			desc = desc + fmt.Sprintf(" with %s", targetCurrency)
+		if feeQuantity.Sign() != 0 {
+			desc = desc + fmt.Sprintf(" with %s", toString(abs(feeQuantity), targetCurrency))
 		}
+	case OperationSendingFee:
+		f := abs(targetQuantity)
+		fee := newEvent(eupholio.EventTypeFee, targetCurrency, f, targetCurrency, f) // valued at the market price
+		events = append(events, fee)
+		desc = fmt.Sprintf("sending fee %s", toString(abs(f), targetCurrency))
 	case OperationBankWithdrawal:
 		withdraw := newEvent(eupholio.EventTypeWithdraw, targetCurrency, neg(sub(targetQuantity, feeQuantity)), fiat, zero)
 		events = append(events, withdraw)
-		if feeQuantity != nil && feeQuantity.Sign() != 0 {
-			fee := newEvent(eupholio.EventTypeFee, targetCurrency, neg(feeQuantity), fiat, neg(feeQuantity))
+		if feeQuantity.Sign() != 0 {
+			fee := newEvent(eupholio.EventTypeFee, targetCurrency, neg(feeQuantity), FiatCode, neg(feeQuantity)) // paid in JPY
 			events = append(events, fee)
 		}
 		desc = fmt.Sprintf("withdraw %s to bank", targetCurrency)
-	case OperationCancelLimitOrder:
+	case OperationLend: // move to the lending account, where the position is still held
+		desc = fmt.Sprintf("lend %s", toString(abs(targetQuantity), targetCurrency))
+	case OperationLendingReturn: // back from the lending account
+		desc = fmt.Sprintf("lending return %s", toString(abs(targetQuantity), targetCurrency))
+	case OperationLendingInterest:
+		interest := abs(targetQuantity)
+		income := &eupholio.Movement{ // income valued at the market price
+			Type:     eupholio.MovementIncome,
+			Label:    eupholio.LabelLending,
+			Received: eupholio.Amount{Currency: targetCurrency, Quantity: interest},
+		}
+		es, err := income.Events(fiat, newEvent)
+		if err != nil {
+			return nil, "", err
+		}
+		events = append(events, es...)
+		desc = fmt.Sprintf("lending interest %s", toString(abs(interest), targetCurrency))
 	default:
 		log.Println("skip type: ", tr.Operation)
 	}
@@ -180,6 +227,10 @@ func sub(x, y *decimal.Big) *decimal.Big {
 	return new(decimal.Big).Sub(x, y)
 }
 
+func abs(x *decimal.Big) *decimal.Big {
+	return new(decimal.Big).Abs(x)
+}
+
 func neg(x *decimal.Big) *decimal.Big {
 	return new(decimal.Big).Neg(x)
 }
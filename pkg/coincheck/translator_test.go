@@ -0,0 +1,100 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package coincheck
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ericlagergren/decimal"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/currency"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+func TestTranslateTransaction(t *testing.T) {
+	h, err := Extract(strings.NewReader(testNewOperationsCsv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dec := func(s string) *decimal.Big {
+		d, _ := new(decimal.Big).SetString(s)
+		return d
+	}
+	type event struct {
+		typ, currency, quantity, baseCurrency, baseQuantity string
+	}
+	expected := [][]event{
+		{ // 約定 (buy BTC by JPY)
+			{eupholio.EventTypeBuy, "BTC", "0.01", "JPY", "23000"},
+			{eupholio.EventTypeSell, "JPY", "23000", "JPY", "23000"},
+		},
+		{ // 約定 (sell BTC for JPY)
+			{eupholio.EventTypeSell, "BTC", "0.01", "JPY", "24000"},
+			{eupholio.EventTypeBuy, "JPY", "24000", "JPY", "24000"},
+		},
+		{}, // 貸暗号資産 貸出
+		{}, // 貸暗号資産 返却
+		{ // 貸暗号資産 利用料 (income valued at the market price)
+			{eupholio.EventTypeBuy, "BTC", "0.0001", "BTC", "0"},
+			{eupholio.EventTypeSell, "BTC", "0.0001", "BTC", "0.0001"},
+			{eupholio.EventTypeBuy, "BTC", "0.0001", "BTC", "0.0001"},
+		},
+		{ // 送金手数料
+			{eupholio.EventTypeFee, "BTC", "0.0005", "BTC", "0.0005"},
+		},
+	}
+	if len(h.Entries) != len(expected) {
+		t.Fatalf("expected %d entries but %d", len(expected), len(h.Entries))
+	}
+	translator := NewTranslator(nil, currency.JPY)
+	for i, entry := range h.Entries {
+		events, desc, err := translator.translateTransaction(&models.Transaction{ID: i + 1}, entry)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if desc == "" {
+			t.Errorf("entry %d: no description", i)
+		}
+		if len(events) != len(expected[i]) {
+			t.Errorf("entry %d (%s): expected %d events but %d", i, desc, len(expected[i]), len(events))
+			continue
+		}
+		for j, e := range expected[i] {
+			a := events[j]
+			if a.Type != e.typ || a.Currency != e.currency || a.Quantity.Big.Cmp(dec(e.quantity)) != 0 ||
+				a.BaseCurrency != e.baseCurrency || a.BaseQuantity.Big.Cmp(dec(e.baseQuantity)) != 0 {
+				t.Errorf("entry %d event %d: expected %v but %s %s %s %s %s", i, j, e,
+					a.Type, a.Currency, a.Quantity.Big, a.BaseCurrency, a.BaseQuantity.Big)
+			}
+		}
+	}
+}
+
+var testNewOperationsCsv = "\ufeff" + `"ID","日時","取引種別","数量","通貨","レート","通貨ペア","手数料","備考"
+"300","2023/01/05 12:00:00","約定","0.01","BTC","2,300,000","btc_jpy","",""
+"301","2023/01/06 12:00:00","約定","24,000","JPY","2,400,000","btc_jpy","",""
+"302","2023/01/07 12:00:00","貸暗号資産 貸出","-0.5","BTC","","","",""
+"303","2023/02/07 12:00:00","貸暗号資産 返却","0.5","BTC","","","",""
+"304","2023/02/07 12:00:00","貸暗号資産 利用料","0.0001","BTC","","","",""
+"305","2023/02/08 12:00:00","送金手数料","-0.0005","BTC","","","",""
+`
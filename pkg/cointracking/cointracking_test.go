@@ -0,0 +1,120 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package cointracking
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/currency"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+func translateAll(t *testing.T, trades models.CointrackingTradeSlice) ([]*eupholio.EventsOfTransaction, models.EventSlice) {
+	translator := NewTranslator(nil, currency.JPY)
+	var ret []*eupholio.EventsOfTransaction
+	var all models.EventSlice
+	for i, tr := range trades {
+		transaction := &models.Transaction{ID: i + 1}
+		events, desc, err := translator.TranslateTransaction(transaction, tr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ret = append(ret, &eupholio.EventsOfTransaction{
+			ID:          transaction.ID,
+			Time:        tr.Date,
+			WalletCode:  WalletCode,
+			Events:      events,
+			Description: desc,
+		})
+		all = append(all, events...)
+	}
+	return ret, all
+}
+
+func TestExportRoundTrip(t *testing.T) {
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	trades, err := NewExtractor(jst).extract(strings.NewReader(testTradeListCsv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	trs, expected := translateAll(t, trades)
+
+	var buf bytes.Buffer
+	skipped, err := NewExporter(jst).Export(&buf, trs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if skipped != 0 {
+		t.Fatalf("%d transactions skipped", skipped)
+	}
+
+	trades2, err := NewExtractor(jst).extract(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, actual := translateAll(t, trades2)
+
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d events but %d", len(expected), len(actual))
+	}
+	for i, e := range expected {
+		a := actual[i]
+		if a.Type != e.Type || a.Currency != e.Currency || a.BaseCurrency != e.BaseCurrency ||
+			a.Quantity.Big.Cmp(e.Quantity.Big) != 0 || a.BaseQuantity.Big.Cmp(e.BaseQuantity.Big) != 0 || !a.Time.Equal(e.Time) {
+			t.Errorf("event %d: expected %s %s %s %s %s but %s %s %s %s %s", i,
+				e.Type, e.Currency, e.Quantity.Big, e.BaseCurrency, e.BaseQuantity.Big,
+				a.Type, a.Currency, a.Quantity.Big, a.BaseCurrency, a.BaseQuantity.Big)
+		}
+	}
+}
+
+func TestUnmappedRows(t *testing.T) {
+	csv := testHeader + `
+"Liquidity Pool","1","ETH","","","","","Uniswap","","","2020-01-02 10:00:00"
+"Deposit","","","1","BTC","","","Kraken","","","2020-01-03 10:00:00"
+"Staking","0.5","ETH","","","","","Kraken","","","2020-01-04 10:00:00"
+`
+	_, err := NewExtractor(time.UTC).extract(strings.NewReader(csv))
+	errs, ok := err.(eupholio.RowErrors)
+	if !ok {
+		t.Fatalf("expected row errors but %v", err)
+	}
+	if len(errs) != 2 || errs[0].Line != 2 || errs[1].Line != 3 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+}
+
+const testHeader = `"Type","Buy","Cur.","Sell","Cur.","Fee","Cur.","Exchange","Trade-Group","Comment","Date"`
+
+var testTradeListCsv = testHeader + `
+"Trade","0.1","BTC","80000","JPY","100","JPY","bitFlyer","","","2020-01-02 10:00:00"
+"Trade","45000","JPY","0.05","BTC","0.0001","BTC","bitFlyer","","","02.01.2020 11:00"
+"Trade","3","ETH","0.1","BTC","","","Kraken","","","2020-01-04 10:00:00"
+"Staking","0.5","ETH","","","","","Kraken","","","2020-01-05 10:00:00"
+"Mining","0.2","ETH","","","","","Pool","","","2020-01-06 10:00:00"
+"Gift","","","0.01","ETH","","","Wallet","","","2020-01-07 10:00:00"
+"Deposit","1","ETH","","","","","Kraken","","","2020-01-08 10:00:00"
+"Withdrawal","","","1","ETH","0.01","ETH","Kraken","","","2020-01-09 10:00:00"
+`
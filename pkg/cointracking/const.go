@@ -0,0 +1,129 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package cointracking
+
+import "github.com/eupholio/eupholio/pkg/eupholio"
+
+const WalletCode = "COINTRACKING"
+
+type ColumnID int
+
+// Column Index of the trade list
+const (
+	Type ColumnID = iota
+	BuyAmount
+	BuyCurrency
+	SellAmount
+	SellCurrency
+	FeeAmount
+	FeeCurrency
+	Exchange
+	Group
+	Comment
+	Date
+	numOfColumns
+)
+
+// columnNames are accepted names of each column, which are not unique ("Cur.")
+var columnNames = [numOfColumns][]string{
+	Type:         {"Type"},
+	BuyAmount:    {"Buy"},
+	BuyCurrency:  {"Cur."},
+	SellAmount:   {"Sell"},
+	SellCurrency: {"Cur."},
+	FeeAmount:    {"Fee"},
+	FeeCurrency:  {"Cur."},
+	Exchange:     {"Exchange"},
+	Group:        {"Group", "Trade-Group", "Trade Group"},
+	Comment:      {"Comment"},
+	Date:         {"Date"},
+}
+
+// Types
+const (
+	TypeTrade             = "Trade"
+	TypeDeposit           = "Deposit"
+	TypeWithdrawal        = "Withdrawal"
+	TypeIncome            = "Income"
+	TypeInterestIncome    = "Interest Income"
+	TypeLendingIncome     = "Lending Income"
+	TypeStaking           = "Staking"
+	TypeMining            = "Mining"
+	TypeAirdrop           = "Airdrop"
+	TypeRewardBonus       = "Reward / Bonus"
+	TypeGiftTip           = "Gift / Tip"
+	TypeMarginProfit      = "Margin Profit"
+	TypeDerivativesProfit = "Derivatives / Futures Profit"
+	TypeSpend             = "Spend"
+	TypeDonation          = "Donation"
+	TypeGift              = "Gift"
+	TypeStolen            = "Stolen"
+	TypeLost              = "Lost"
+	TypeOtherFee          = "Other Fee"
+	TypeMarginFee         = "Margin Fee"
+	TypeMarginLoss        = "Margin Loss"
+	TypeDerivativesLoss   = "Derivatives / Futures Loss"
+)
+
+// incomeTypes maps types of received amounts to income labels of eupholio
+var incomeTypes = map[string]string{
+	TypeIncome:            eupholio.LabelIncome,
+	TypeInterestIncome:    eupholio.LabelLending,
+	TypeLendingIncome:     eupholio.LabelLending,
+	TypeStaking:           eupholio.LabelStaking,
+	TypeMining:            eupholio.LabelMining,
+	TypeAirdrop:           eupholio.LabelAirdrop,
+	TypeRewardBonus:       eupholio.LabelIncome,
+	TypeGiftTip:           "gift",
+	TypeMarginProfit:      eupholio.LabelIncome,
+	TypeDerivativesProfit: eupholio.LabelIncome,
+}
+
+// outflowTypes maps types of sent amounts which are disposed as fees to fee labels of eupholio
+var outflowTypes = map[string]string{
+	TypeSpend:           "spend",
+	TypeDonation:        "donation",
+	TypeGift:            "gift",
+	TypeStolen:          "stolen",
+	TypeLost:            "lost",
+	TypeOtherFee:        eupholio.LabelCost,
+	TypeMarginFee:       eupholio.LabelCost,
+	TypeMarginLoss:      eupholio.LabelCost,
+	TypeDerivativesLoss: eupholio.LabelCost,
+}
+
+// exportTypes maps income and fee labels of eupholio to types of the trade list
+var exportTypes = map[eupholio.MovementType]map[string]string{
+	eupholio.MovementIncome: {
+		eupholio.LabelLending: TypeInterestIncome,
+		eupholio.LabelStaking: TypeStaking,
+		eupholio.LabelMining:  TypeMining,
+		eupholio.LabelAirdrop: TypeAirdrop,
+		"gift":                TypeGiftTip,
+	},
+	eupholio.MovementFee: {
+		"spend":    TypeSpend,
+		"donation": TypeDonation,
+		"gift":     TypeGift,
+		"stolen":   TypeStolen,
+		"lost":     TypeLost,
+	},
+}
@@ -0,0 +1,116 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package cointracking
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+// Exporter writes events of transactions as a trade list
+type Exporter struct {
+	loc *time.Location
+}
+
+// NewExporter create an exporter of trade lists
+func NewExporter(loc *time.Location) *Exporter {
+	return &Exporter{
+		loc: loc,
+	}
+}
+
+// Export writes rows translated from transactions, and returns the number of transactions which cannot be exported
+func (e *Exporter) Export(writer io.Writer, transactions []*eupholio.EventsOfTransaction) (int, error) {
+	w := csv.NewWriter(writer)
+	head := make([]string, 0, numOfColumns)
+	for _, names := range columnNames {
+		head = append(head, names[0])
+	}
+	if err := w.Write(head); err != nil {
+		return 0, err
+	}
+	skipped := 0
+	for _, tr := range transactions {
+		rows, err := e.Rows(tr)
+		if err != nil {
+			log.Printf("skip transaction %d (%s %s): %v", tr.ID, tr.WalletCode, tr.Description, err)
+			skipped++
+			continue
+		}
+		for _, row := range rows {
+			if err := w.Write(row); err != nil {
+				return skipped, err
+			}
+		}
+	}
+	w.Flush()
+	return skipped, w.Error()
+}
+
+// Rows translates events of a transaction to rows of the trade list.
+// Values of incomes and fees are not exported because the trade list has no column for them.
+func (e *Exporter) Rows(tr *eupholio.EventsOfTransaction) ([][]string, error) {
+	ms, err := eupholio.Movements(tr)
+	if err != nil {
+		return nil, err
+	}
+	var rows [][]string
+	for _, m := range ms {
+		var typ string
+		switch m.Type {
+		case eupholio.MovementTrade:
+			typ = TypeTrade
+		case eupholio.MovementDeposit:
+			typ = TypeDeposit
+		case eupholio.MovementWithdraw:
+			typ = TypeWithdrawal
+		case eupholio.MovementIncome:
+			typ = TypeIncome
+		case eupholio.MovementFee:
+			typ = TypeOtherFee
+		}
+		if t, ok := exportTypes[m.Type][m.Label]; ok {
+			typ = t
+		}
+		row := make([]string, numOfColumns)
+		row[Type] = typ
+		row[BuyAmount], row[BuyCurrency] = quantity(m.Received), m.Received.Currency
+		row[SellAmount], row[SellCurrency] = quantity(m.Sent), m.Sent.Currency
+		row[FeeAmount], row[FeeCurrency] = quantity(m.Fee), m.Fee.Currency
+		row[Exchange] = tr.WalletCode
+		row[Comment] = tr.Description
+		row[Date] = tr.Time.In(e.loc).Format(recordTimeFormat)
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func quantity(a eupholio.Amount) string {
+	if a.Quantity == nil {
+		return ""
+	}
+	return fmt.Sprintf("%f", a.Quantity)
+}
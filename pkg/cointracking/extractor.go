@@ -0,0 +1,211 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package cointracking
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+const recordTimeFormat = "2006-01-02 15:04:05"
+
+// recordTimeFormats are accepted formats of the date column
+var recordTimeFormats = []string{
+	recordTimeFormat,
+	"2006-01-02 15:04",
+	"02.01.2006 15:04:05",
+	"02.01.2006 15:04",
+}
+
+// Extractor for CoinTracking trade lists
+type Extractor struct {
+	loc *time.Location
+}
+
+// NewExtractor create an extractor for CoinTracking trade lists
+func NewExtractor(loc *time.Location) *Extractor {
+	return &Extractor{
+		loc: loc,
+	}
+}
+
+// Execute performs ETL
+func (e *Extractor) Execute(ctx context.Context, db boil.ContextExecutor, reader io.Reader, options ...eupholio.Option) error {
+	config := &eupholio.Config{}
+	for _, o := range options {
+		o(config)
+	}
+
+	trades, err := e.extract(reader)
+	if errs, ok := err.(eupholio.RowErrors); ok {
+		err = config.HandleRowErrors(errs)
+	}
+	if err != nil {
+		return err
+	}
+	for _, tr := range trades {
+		err := config.InsertRow(ctx, db, models.TableNames.CointrackingTrades, tr)
+		if err != nil {
+			if config.Debug {
+				log.Print(tr)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// extract extracts trades from a reader, and reports all rows which cannot be mapped to movements
+func (e *Extractor) extract(reader io.Reader) (models.CointrackingTradeSlice, error) {
+	r := csv.NewReader(reader)
+
+	csvHead, err := r.Read()
+	if err != nil {
+		return nil, &eupholio.RowError{Line: 1, Err: err}
+	}
+	if err := ValidateColumnNames(csvHead); err != nil {
+		return nil, &eupholio.RowError{Line: 1, Err: err}
+	}
+	csvRows, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var trades models.CointrackingTradeSlice
+	var errs eupholio.RowErrors
+
+	for i, row := range csvRows {
+		n := i + 2
+		tr, err := Record(row).Trade(e.loc)
+		if err == nil {
+			_, err = ToMovement(tr)
+		}
+		if err != nil {
+			errs = append(errs, &eupholio.RowError{Line: n, Err: err})
+			continue
+		}
+		trades = append(trades, tr)
+	}
+	if len(errs) > 0 {
+		return trades, errs
+	}
+
+	return trades, nil
+}
+
+// ValidateColumnNames checks columns
+func ValidateColumnNames(names []string) error {
+	if len(names) > int(numOfColumns) {
+		return fmt.Errorf("unknown column %s found", names[numOfColumns])
+	}
+	if len(names) < int(numOfColumns) {
+		return fmt.Errorf("column %s not found", columnNames[len(names)][0])
+	}
+	for i, n := range names {
+		if !contains(columnNames[i], strings.TrimSpace(n)) {
+			return fmt.Errorf("unknown column %s found", n)
+		}
+	}
+	return nil
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+type Record []string
+
+func (r Record) Get(id ColumnID) string {
+	return strings.TrimSpace(r[id])
+}
+
+func (r Record) Date(loc *time.Location) (time.Time, error) {
+	date := r.Get(Date)
+	for _, layout := range recordTimeFormats {
+		if t, err := time.ParseInLocation(layout, date, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date '%s'", date)
+}
+
+// GetAsDecimal returns a decimal value, or zero for an empty column
+func (r Record) GetAsDecimal(id ColumnID) (*decimal.Big, error) {
+	s := r.Get(id)
+	if len(s) == 0 || s == "-" {
+		return decimal.New(0, 0), nil
+	}
+	if b, ok := new(decimal.Big).SetString(s); ok {
+		return b, nil
+	}
+	return nil, fmt.Errorf("invalid decimal '%s'", s)
+}
+
+// Trade makes a row of cointracking_trades
+func (r Record) Trade(loc *time.Location) (*models.CointrackingTrade, error) {
+	date, err := r.Date(loc)
+	if err != nil {
+		return nil, err
+	}
+	buy, err := r.GetAsDecimal(BuyAmount)
+	if err != nil {
+		return nil, err
+	}
+	sell, err := r.GetAsDecimal(SellAmount)
+	if err != nil {
+		return nil, err
+	}
+	fee, err := r.GetAsDecimal(FeeAmount)
+	if err != nil {
+		return nil, err
+	}
+	return &models.CointrackingTrade{
+		Type:         r.Get(Type),
+		BuyAmount:    types.NewDecimal(buy),
+		BuyCurrency:  r.Get(BuyCurrency),
+		SellAmount:   types.NewDecimal(sell),
+		SellCurrency: r.Get(SellCurrency),
+		FeeAmount:    types.NewDecimal(fee),
+		FeeCurrency:  r.Get(FeeCurrency),
+		Exchange:     r.Get(Exchange),
+		Group:        r.Get(Group),
+		Comment:      r.Get(Comment),
+		Date:         date,
+	}, nil
+}
@@ -0,0 +1,74 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package cointracking
+
+import (
+	"fmt"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+// ToMovement maps a trade to a movement by the type
+func ToMovement(tr *models.CointrackingTrade) (*eupholio.Movement, error) {
+	m := &eupholio.Movement{
+		Sent:     eupholio.Amount{Currency: tr.SellCurrency, Quantity: tr.SellAmount.Big},
+		Received: eupholio.Amount{Currency: tr.BuyCurrency, Quantity: tr.BuyAmount.Big},
+		Fee:      eupholio.Amount{Currency: tr.FeeCurrency, Quantity: tr.FeeAmount.Big},
+	}
+	sent, received := !m.Sent.IsZero(), !m.Received.IsZero()
+	if (sent && m.Sent.Quantity.Sign() < 0) || (received && m.Received.Quantity.Sign() < 0) || (!m.Fee.IsZero() && m.Fee.Quantity.Sign() < 0) {
+		return nil, fmt.Errorf("negative amount")
+	}
+
+	switch {
+	case tr.Type == TypeTrade:
+		if !sent || !received {
+			return nil, fmt.Errorf("trade requires both buy and sell amounts")
+		}
+		m.Type = eupholio.MovementTrade
+		return m, nil
+	case tr.Type == TypeDeposit:
+		m.Type = eupholio.MovementDeposit
+	case tr.Type == TypeWithdrawal:
+		m.Type = eupholio.MovementWithdraw
+	default:
+		if label, ok := incomeTypes[tr.Type]; ok {
+			m.Type, m.Label = eupholio.MovementIncome, label
+		} else if label, ok := outflowTypes[tr.Type]; ok {
+			m.Type, m.Label = eupholio.MovementFee, label
+		} else {
+			return nil, fmt.Errorf("unknown type '%s'", tr.Type)
+		}
+	}
+
+	switch m.Type {
+	case eupholio.MovementDeposit, eupholio.MovementIncome:
+		if !received || sent {
+			return nil, fmt.Errorf("%s requires only a buy amount", tr.Type)
+		}
+	case eupholio.MovementWithdraw, eupholio.MovementFee:
+		if !sent || received {
+			return nil, fmt.Errorf("%s requires only a sell amount", tr.Type)
+		}
+	}
+	return m, nil
+}
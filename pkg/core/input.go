@@ -0,0 +1,222 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package core converts entries calculated by eupholio to the input of eupholio-core-cli,
+// which is the Rust implementation of the cost calculation (see eupholio-core/doc/04-cli.md),
+// and compares its report with calculated balances.
+package core
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+// Methods
+const (
+	MethodMovingAverage = "moving_average"
+	MethodTotalAverage  = "total_average"
+)
+
+// Event types
+const (
+	EventTypeAcquire  = "Acquire"
+	EventTypeDispose  = "Dispose"
+	EventTypeIncome   = "Income"
+	EventTypeTransfer = "Transfer"
+)
+
+// Transfer directions
+const (
+	DirectionIn  = "In"
+	DirectionOut = "Out"
+)
+
+// Input is the input of eupholio-core-cli
+type Input struct {
+	Method  string              `json:"method"`
+	TaxYear int                 `json:"tax_year"`
+	CarryIn map[string]*CarryIn `json:"carry_in,omitempty"`
+	Events  []*Event            `json:"events"`
+}
+
+// CarryIn is the quantity and the cost of an asset carried from the previous year
+type CarryIn struct {
+	Qty  string `json:"qty"`
+	Cost string `json:"cost"`
+}
+
+// Event is an event of eupholio-core, whose values are in JPY
+type Event struct {
+	Type        string    `json:"type"`
+	ID          string    `json:"id"`
+	Asset       string    `json:"asset"`
+	Qty         string    `json:"qty"`
+	JpyCost     string    `json:"jpy_cost,omitempty"`
+	JpyProceeds string    `json:"jpy_proceeds,omitempty"`
+	JpyValue    string    `json:"jpy_value,omitempty"`
+	Direction   string    `json:"direction,omitempty"`
+	Ts          time.Time `json:"ts"`
+}
+
+// Builder builds the input from entries and events of a year
+type Builder struct {
+	fiat        string
+	loc         *time.Location
+	splitIncome bool
+}
+
+// NewBuilder create a builder for years in loc. If splitIncome is true, incomes are exported as acquisitions and disposals
+// in the same way as eupholio calculates them, otherwise they are exported as Income events.
+func NewBuilder(fiat string, loc *time.Location, splitIncome bool) *Builder {
+	return &Builder{
+		fiat:        fiat,
+		loc:         loc,
+		splitIncome: splitIncome,
+	}
+}
+
+// ts returns the local time in loc as a time in UTC, because eupholio-core assigns events to years in UTC
+func (b *Builder) ts(t time.Time) time.Time {
+	l := t.In(b.loc)
+	return time.Date(l.Year(), l.Month(), l.Day(), l.Hour(), l.Minute(), l.Second(), l.Nanosecond(), time.UTC)
+}
+
+// Build makes the input of a year. Balances of the previous year are passed as carry_in for the total average method,
+// or as acquisitions at the beginning of the year for the moving average method, which ignores carry_in.
+func (b *Builder) Build(method string, year int, entries []*eupholio.EntriesOfTransaction, events []*eupholio.EventsOfTransaction, lastBalances models.BalanceSlice) (*Input, error) {
+	if method != MethodMovingAverage && method != MethodTotalAverage {
+		return nil, fmt.Errorf("unknown method %s", method)
+	}
+	input := &Input{
+		Method:  method,
+		TaxYear: year,
+		Events:  []*Event{},
+	}
+
+	carryIn := b.CarryIn(lastBalances)
+	if method == MethodTotalAverage {
+		if len(carryIn) > 0 {
+			input.CarryIn = carryIn
+		}
+	} else {
+		start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		assets := make([]string, 0, len(carryIn))
+		for asset := range carryIn {
+			assets = append(assets, asset)
+		}
+		sort.Strings(assets)
+		for _, asset := range assets {
+			c := carryIn[asset]
+			input.Events = append(input.Events, &Event{Type: EventTypeAcquire, ID: "carry-in:" + asset, Asset: asset, Qty: c.Qty, JpyCost: c.Cost, Ts: start})
+		}
+	}
+
+	var es []*Event
+	for _, tr := range entries {
+		es = append(es, b.entryEvents(tr)...)
+	}
+	for _, tr := range events {
+		es = append(es, b.transferEvents(tr)...)
+	}
+	sort.SliceStable(es, func(i, j int) bool {
+		return es[i].Ts.Before(es[j].Ts)
+	})
+	input.Events = append(input.Events, es...)
+	return input, nil
+}
+
+// CarryIn returns quantities and costs of balances except the fiat
+func (b *Builder) CarryIn(balances models.BalanceSlice) map[string]*CarryIn {
+	ret := make(map[string]*CarryIn)
+	for _, balance := range balances {
+		if balance.Currency == b.fiat || balance.Quantity.Big.Sign() <= 0 {
+			continue
+		}
+		cost := new(decimal.Big).Mul(balance.Price.Big, balance.Quantity.Big)
+		ret[balance.Currency] = &CarryIn{Qty: format(balance.Quantity.Big), Cost: format(cost)}
+	}
+	return ret
+}
+
+// entryEvents converts open entries to Acquire and close entries to Dispose, and an income
+// (acquisition without cost, earning and re-acquisition of the same quantity) to Income
+func (b *Builder) entryEvents(tr *eupholio.EntriesOfTransaction) []*Event {
+	var ret []*Event
+	entries := tr.Entries
+	for i := 0; i < len(entries); i++ {
+		e := entries[i]
+		if e.Currency == b.fiat || e.Quantity.Big.Sign() == 0 {
+			continue
+		}
+		if !b.splitIncome && i+2 < len(entries) && isIncome(e, entries[i+1], entries[i+2]) {
+			ret = append(ret, &Event{Type: EventTypeIncome, ID: fmt.Sprintf("entry-%d:income", e.ID), Asset: e.Currency, Qty: format(e.Quantity.Big), JpyValue: format(entries[i+1].FiatQuantity.Big), Ts: b.ts(e.Time)})
+			i += 2
+			continue
+		}
+		switch e.Type {
+		case eupholio.EntryTypeOpen:
+			ret = append(ret, &Event{Type: EventTypeAcquire, ID: fmt.Sprintf("entry-%d", e.ID), Asset: e.Currency, Qty: format(e.Quantity.Big), JpyCost: format(e.FiatQuantity.Big), Ts: b.ts(e.Time)})
+		case eupholio.EntryTypeClose:
+			ret = append(ret, &Event{Type: EventTypeDispose, ID: fmt.Sprintf("entry-%d", e.ID), Asset: e.Currency, Qty: format(e.Quantity.Big), JpyProceeds: format(e.FiatQuantity.Big), Ts: b.ts(e.Time)})
+		}
+	}
+	return ret
+}
+
+// transferEvents converts deposits and withdrawals, which have no entries, to Transfer.
+// Commissions are left out, because translators deduct them from the traded quantities and
+// costmethod.CalculateFiatPrice only records their values on the entries without changing positions.
+func (b *Builder) transferEvents(tr *eupholio.EventsOfTransaction) []*Event {
+	var ret []*Event
+	for _, e := range tr.Events {
+		if e.Currency == b.fiat || e.Quantity.Big.Sign() == 0 {
+			continue
+		}
+		direction := ""
+		switch e.Type {
+		case eupholio.EventTypeDeposit:
+			direction = DirectionIn
+		case eupholio.EventTypeWithdraw:
+			direction = DirectionOut
+		default:
+			continue
+		}
+		ret = append(ret, &Event{Type: EventTypeTransfer, ID: fmt.Sprintf("event-%d", e.ID), Asset: e.Currency, Qty: format(e.Quantity.Big), Direction: direction, Ts: b.ts(e.Time)})
+	}
+	return ret
+}
+
+func isIncome(open, earning, reopen *models.Entry) bool {
+	return open.Type == eupholio.EntryTypeOpen && earning.Type == eupholio.EntryTypeClose && reopen.Type == eupholio.EntryTypeOpen &&
+		open.FiatQuantity.Big.Sign() == 0 &&
+		open.Currency == earning.Currency && open.Currency == reopen.Currency &&
+		open.Quantity.Big.Cmp(earning.Quantity.Big) == 0 && open.Quantity.Big.Cmp(reopen.Quantity.Big) == 0 &&
+		earning.FiatQuantity.Big.Cmp(reopen.FiatQuantity.Big) == 0
+}
+
+func format(x *decimal.Big) string {
+	return fmt.Sprintf("%f", new(decimal.Big).Abs(x))
+}
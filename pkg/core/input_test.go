@@ -0,0 +1,104 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package core
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+func TestBuild(t *testing.T) {
+	ts := time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC)
+	newEntry := func(id int, typ, currency string, quantity, fiat *decimal.Big) *models.Entry {
+		return &models.Entry{ID: id, Time: ts, Type: typ, Currency: currency, Quantity: types.NewDecimal(quantity), FiatQuantity: types.NewDecimal(fiat)}
+	}
+	entries := []*eupholio.EntriesOfTransaction{
+		{ID: 1, Entries: models.EntrySlice{
+			newEntry(1, eupholio.EntryTypeClose, "JPY", decimal.New(80000, 0), decimal.New(80000, 0)),
+			newEntry(2, eupholio.EntryTypeOpen, "BTC", decimal.New(1, 1), decimal.New(80000, 0)),
+		}},
+		{ID: 2, Entries: models.EntrySlice{
+			newEntry(3, eupholio.EntryTypeOpen, "ETH", decimal.New(5, 1), decimal.New(0, 0)),
+			newEntry(4, eupholio.EntryTypeClose, "ETH", decimal.New(5, 1), decimal.New(10000, 0)),
+			newEntry(5, eupholio.EntryTypeOpen, "ETH", decimal.New(5, 1), decimal.New(10000, 0)),
+		}},
+	}
+	events := []*eupholio.EventsOfTransaction{
+		{ID: 3, Events: models.EventSlice{
+			{ID: 6, Time: ts, Type: eupholio.EventTypeWithdraw, Currency: "BTC", Quantity: types.NewDecimal(decimal.New(1, 1)), BaseQuantity: types.NewDecimal(decimal.New(0, 0))},
+		}},
+	}
+	balances := models.BalanceSlice{
+		{Currency: "BTC", Quantity: types.NewDecimal(decimal.New(2, 0)), Price: types.NewDecimal(decimal.New(4000000, 0))},
+		{Currency: "JPY", Quantity: types.NewDecimal(decimal.New(100, 0)), Price: types.NewDecimal(decimal.New(1, 0))},
+	}
+
+	input, err := NewBuilder("JPY", time.UTC, false).Build(MethodTotalAverage, 2020, entries, events, balances)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"method":"total_average","tax_year":2020,"carry_in":{"BTC":{"qty":"2","cost":"8000000"}},"events":[` +
+		`{"type":"Acquire","id":"entry-2","asset":"BTC","qty":"0.1","jpy_cost":"80000","ts":"2020-03-01T00:00:00Z"},` +
+		`{"type":"Income","id":"entry-3:income","asset":"ETH","qty":"0.5","jpy_value":"10000","ts":"2020-03-01T00:00:00Z"},` +
+		`{"type":"Transfer","id":"event-6","asset":"BTC","qty":"0.1","direction":"Out","ts":"2020-03-01T00:00:00Z"}]}`
+	if string(b) != expected {
+		t.Errorf("expected %s but %s", expected, string(b))
+	}
+
+	input, err = NewBuilder("JPY", time.UTC, true).Build(MethodMovingAverage, 2020, entries, nil, balances)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if input.CarryIn != nil || len(input.Events) != 5 || input.Events[0].ID != "carry-in:BTC" || input.Events[2].Type != EventTypeAcquire || input.Events[2].JpyCost != "0" {
+		t.Errorf("unexpected input %+v", input)
+	}
+}
+
+func TestBuildLocalYear(t *testing.T) {
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	ts := time.Date(2021, time.January, 1, 5, 0, 0, 0, jst) // 2020-12-31 20:00 in UTC
+	entries := []*eupholio.EntriesOfTransaction{
+		{ID: 1, Entries: models.EntrySlice{
+			{ID: 1, Time: ts, Type: eupholio.EntryTypeOpen, Currency: "BTC", Quantity: types.NewDecimal(decimal.New(1, 1)), FiatQuantity: types.NewDecimal(decimal.New(80000, 0))},
+		}},
+	}
+	input, err := NewBuilder("JPY", jst, false).Build(MethodTotalAverage, 2021, entries, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(input.Events) != 1 {
+		t.Fatalf("expected 1 event but %d", len(input.Events))
+	}
+	if e := input.Events[0]; e.Ts.Year() != 2021 || !e.Ts.Equal(time.Date(2021, time.January, 1, 5, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected ts %v", e.Ts)
+	}
+}
@@ -0,0 +1,79 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// Report is the output of eupholio-core-cli, whose values are in JPY
+type Report struct {
+	Positions     map[string]*Position `json:"positions"`
+	RealizedPnl   string               `json:"realized_pnl_jpy"`
+	Income        string               `json:"income_jpy"`
+	YearlySummary *YearlySummary       `json:"yearly_summary"`
+	Diagnostics   []json.RawMessage    `json:"diagnostics"`
+}
+
+// Position is the quantity and the average cost of an asset at the end of the year
+type Position struct {
+	Qty         string `json:"qty"`
+	AverageCost string `json:"avg_cost_jpy_per_unit"`
+}
+
+// YearlySummary is the summary reported by the total average method
+type YearlySummary struct {
+	TaxYear int                            `json:"tax_year"`
+	ByAsset map[string]*YearlyAssetSummary `json:"by_asset"`
+}
+
+// YearlyAssetSummary is the summary of an asset
+type YearlyAssetSummary struct {
+	AverageCost string `json:"average_cost_per_unit"`
+	RealizedPnl string `json:"realized_pnl_jpy"`
+	CarryOutQty string `json:"carry_out_qty"`
+}
+
+// Run runs "calc" of eupholio-core-cli with the input
+func Run(ctx context.Context, cli string, input *Input) (*Report, error) {
+	b, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, cli, "calc")
+	cmd.Stdin = bytes.NewReader(b)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("%s calc: %w: %s", cli, err, strings.TrimSpace(stderr.String()))
+	}
+	var report Report
+	if err := json.Unmarshal(stdout.Bytes(), &report); err != nil {
+		return nil, fmt.Errorf("%s calc: %w", cli, err)
+	}
+	return &report, nil
+}
@@ -0,0 +1,148 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package core
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ericlagergren/decimal"
+
+	"github.com/eupholio/eupholio/models"
+)
+
+// Compared fields
+const (
+	FieldQuantity = "quantity"
+	FieldProfit   = "profit"
+)
+
+// TotalCurrency is the currency name of the total profit
+const TotalCurrency = "TOTAL"
+
+// QuantityTolerance is the tolerance of quantities, which are rounded to 8 decimal places by eupholio-core
+var QuantityTolerance = decimal.New(1, 8)
+
+// Difference is a value which eupholio and eupholio-core-cli disagree on
+type Difference struct {
+	Currency string
+	Field    string
+	Value    *decimal.Big
+	Core     *decimal.Big
+}
+
+// Delta returns the difference of the values
+func (d *Difference) Delta() *decimal.Big {
+	return new(decimal.Big).Sub(d.Value, d.Core)
+}
+
+func (d *Difference) String() string {
+	return fmt.Sprintf("%s %s: %f (eupholio) %f (core) %f (delta)", d.Currency, d.Field, d.Value, d.Core, d.Delta())
+}
+
+// Compare compares balances of a year with the report of eupholio-core-cli, and returns differences beyond the tolerance.
+// Profits are compared per currency if the report has the yearly summary, and the total profit is compared always.
+func Compare(balances models.BalanceSlice, report *Report, fiat string, tolerance *decimal.Big) ([]*Difference, error) {
+	var diffs []*Difference
+	add := func(currency, field string, value, core, tolerance *decimal.Big) {
+		delta := new(decimal.Big).Sub(value, core)
+		if delta.Abs(delta).Cmp(tolerance) > 0 {
+			diffs = append(diffs, &Difference{Currency: currency, Field: field, Value: value, Core: core})
+		}
+	}
+
+	quantities := map[string]*decimal.Big{}
+	profits := map[string]*decimal.Big{}
+	totalProfit := new(decimal.Big)
+	for _, balance := range balances {
+		if balance.Currency == fiat {
+			continue
+		}
+		quantities[balance.Currency] = balance.Quantity.Big
+		profits[balance.Currency] = balance.Profit.Big
+		totalProfit.Add(totalProfit, balance.Profit.Big)
+	}
+
+	coreQuantities := map[string]*decimal.Big{}
+	for currency, position := range report.Positions {
+		qty, err := parse(position.Qty)
+		if err != nil {
+			return nil, fmt.Errorf("position of %s: %w", currency, err)
+		}
+		coreQuantities[currency] = qty
+	}
+	for _, currency := range currencies(quantities, coreQuantities) {
+		add(currency, FieldQuantity, zeroIfNil(quantities[currency]), zeroIfNil(coreQuantities[currency]), QuantityTolerance)
+	}
+
+	if report.YearlySummary != nil {
+		coreProfits := map[string]*decimal.Big{}
+		for currency, summary := range report.YearlySummary.ByAsset {
+			profit, err := parse(summary.RealizedPnl)
+			if err != nil {
+				return nil, fmt.Errorf("realized pnl of %s: %w", currency, err)
+			}
+			coreProfits[currency] = profit
+		}
+		for _, currency := range currencies(profits, coreProfits) {
+			add(currency, FieldProfit, zeroIfNil(profits[currency]), zeroIfNil(coreProfits[currency]), tolerance)
+		}
+	}
+
+	coreTotalProfit, err := parse(report.RealizedPnl)
+	if err != nil {
+		return nil, fmt.Errorf("realized pnl: %w", err)
+	}
+	add(TotalCurrency, FieldProfit, totalProfit, coreTotalProfit, tolerance)
+	return diffs, nil
+}
+
+func parse(s string) (*decimal.Big, error) {
+	if s == "" {
+		return new(decimal.Big), nil
+	}
+	x, ok := new(decimal.Big).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("invalid decimal %q", s)
+	}
+	return x, nil
+}
+
+func zeroIfNil(x *decimal.Big) *decimal.Big {
+	if x == nil {
+		return new(decimal.Big)
+	}
+	return x
+}
+
+func currencies(a, b map[string]*decimal.Big) []string {
+	var ret []string
+	for currency := range a {
+		ret = append(ret, currency)
+	}
+	for currency := range b {
+		if _, ok := a[currency]; !ok {
+			ret = append(ret, currency)
+		}
+	}
+	sort.Strings(ret)
+	return ret
+}
@@ -0,0 +1,69 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package core
+
+import (
+	"testing"
+
+	"github.com/ericlagergren/decimal"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/eupholio/eupholio/models"
+)
+
+func TestCompare(t *testing.T) {
+	balances := models.BalanceSlice{
+		{Year: 2020, Currency: "BTC", Quantity: types.NewDecimal(decimal.New(1, 1)), Profit: types.NewDecimal(decimal.New(10004, 1))},
+		{Year: 2020, Currency: "ETH", Quantity: types.NewDecimal(decimal.New(0, 0)), Profit: types.NewDecimal(decimal.New(-200, 0))},
+	}
+	report := &Report{
+		Positions: map[string]*Position{
+			"BTC": {Qty: "0.10000000", AverageCost: "800000"},
+			"XRP": {Qty: "10"},
+		},
+		RealizedPnl: "800",
+		YearlySummary: &YearlySummary{TaxYear: 2020, ByAsset: map[string]*YearlyAssetSummary{
+			"BTC": {RealizedPnl: "1000"},
+			"ETH": {RealizedPnl: "-200"},
+		}},
+	}
+
+	diffs, err := Compare(balances, report, "JPY", decimal.New(1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diffs) != 1 || diffs[0].Currency != "XRP" || diffs[0].Field != FieldQuantity {
+		t.Fatalf("unexpected differences %v", diffs)
+	}
+
+	report.RealizedPnl = "900"
+	report.YearlySummary.ByAsset["ETH"].RealizedPnl = "-100"
+	diffs, err = Compare(balances, report, "JPY", decimal.New(1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diffs) != 3 || diffs[1].Currency != "ETH" || diffs[2].Currency != TotalCurrency {
+		t.Fatalf("unexpected differences %v", diffs)
+	}
+	if diffs[2].Delta().Cmp(decimal.New(-996, 1)) != 0 {
+		t.Errorf("unexpected delta %f", diffs[2].Delta())
+	}
+}
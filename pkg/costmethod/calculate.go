@@ -33,7 +33,14 @@ import (
 	"github.com/eupholio/eupholio/pkg/eupholio"
 )
 
+// CalculateFiatPrice creates entries of a year from events, replacing entries calculated before
 func CalculateFiatPrice(ctx context.Context, repo eupholio.Repository, year int, loc *time.Location, fiat currency.Symbol) error {
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+	end := time.Date(year+1, time.January, 1, 0, 0, 0, 0, loc)
+	if _, err := repo.DeleteEntriesByStartAndEnd(ctx, start, end); err != nil {
+		return err
+	}
+
 	lastBalances, err := repo.FindBalancesByYear(ctx, year-1)
 	if err != nil {
 		return err
@@ -128,6 +135,10 @@ func UpdateBalanceByYear(ctx context.Context, repo eupholio.Repository, year int
 	start := time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
 	end := time.Date(year+1, time.January, 1, 0, 0, 0, 0, loc)
 
+	if _, err := repo.DeleteBalancesByYear(ctx, year); err != nil {
+		return err
+	}
+
 	lastBalances, err := repo.FindBalancesByYear(ctx, year-1)
 	if err != nil {
 		return err
@@ -22,7 +22,7 @@ package cryptact
 
 const WalletCode = "CRYPTACT_C"
 
-// Actions (BUY/SELL/PAY/MINING/SENDFEE/TIP/REDUCE/BONUS/LENDING/STAKING)
+// Actions (BUY/SELL/PAY/MINING/SENDFEE/TIP/REDUCE/BONUS/LENDING/STAKING/DEFIFEE/LEND/RECOVER/BORROW/RETURN)
 const (
 	ActionBuy     = "BUY"
 	ActionSell    = "SELL"
@@ -34,6 +34,11 @@ const (
 	ActionBonus   = "BONUS"
 	ActionLending = "LENDING"
 	ActionStaking = "STAKING"
+	ActionDefiFee = "DEFIFEE"
+	ActionLend    = "LEND"
+	ActionRecover = "RECOVER"
+	ActionBorrow  = "BORROW"
+	ActionReturn  = "RETURN"
 )
 
 // Column names
@@ -0,0 +1,223 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package cryptact
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+// Row is a row of the custom file
+type Row struct {
+	Timestamp time.Time
+	Action    string
+	Source    string
+	Base      string
+	Volume    *decimal.Big
+	Price     *decimal.Big // nil means the market price
+	Counter   string
+	Fee       *decimal.Big
+	FeeCcy    string
+	Comment   string
+}
+
+func (r *Row) strings(loc *time.Location) []string {
+	price := ""
+	if r.Price != nil {
+		price = fmt.Sprintf("%f", r.Price)
+	}
+	return []string{
+		r.Timestamp.In(loc).Format(recordTimeFormat),
+		r.Action,
+		r.Source,
+		r.Base,
+		fmt.Sprintf("%f", r.Volume),
+		price,
+		r.Counter,
+		fmt.Sprintf("%f", r.Fee),
+		r.FeeCcy,
+		r.Comment,
+	}
+}
+
+// Exporter writes events of transactions as the custom file
+type Exporter struct {
+	fiat string
+	loc  *time.Location
+}
+
+// NewExporter create an exporter of the custom file
+func NewExporter(fiat string, loc *time.Location) *Exporter {
+	return &Exporter{
+		fiat: fiat,
+		loc:  loc,
+	}
+}
+
+// Export writes rows translated from transactions, and returns the number of transactions which cannot be exported.
+// Transactions with deposits or withdrawals are counted as skipped, because the custom file has no actions for them.
+func (e *Exporter) Export(writer io.Writer, transactions []*eupholio.EventsOfTransaction) (int, error) {
+	w := csv.NewWriter(writer)
+	if err := w.Write(columnNames); err != nil {
+		return 0, err
+	}
+	skipped := 0
+	for _, tr := range transactions {
+		rows, err := e.Rows(tr)
+		if err != nil {
+			log.Printf("skip transaction %d (%s %s): %v", tr.ID, tr.WalletCode, tr.Description, err)
+			skipped++
+			continue
+		}
+		if n := countTransfers(tr); n > 0 {
+			log.Printf("skip %d deposit and withdrawal events of transaction %d (%s %s)", n, tr.ID, tr.WalletCode, tr.Description)
+			skipped++
+		}
+		for _, row := range rows {
+			if err := w.Write(row.strings(e.loc)); err != nil {
+				return skipped, err
+			}
+		}
+	}
+	w.Flush()
+	return skipped, w.Error()
+}
+
+// Rows translates movements of a transaction to rows, which are translated back to the same events by Translator.
+// Deposits and withdrawals are omitted because they have no effect on the calculation.
+func (e *Exporter) Rows(tr *eupholio.EventsOfTransaction) ([]*Row, error) {
+	newRow := func(action, base string, volume, price *decimal.Big, counter string, fee *decimal.Big, feeCcy string) *Row {
+		if fee == nil {
+			fee = decimal.New(0, 0)
+		}
+		if feeCcy == "" {
+			feeCcy = counter
+		}
+		return &Row{
+			Timestamp: tr.Time,
+			Action:    action,
+			Source:    tr.WalletCode,
+			Base:      base,
+			Volume:    volume,
+			Price:     price,
+			Counter:   counter,
+			Fee:       fee,
+			FeeCcy:    feeCcy,
+			Comment:   tr.Description,
+		}
+	}
+	// price and counter currency of a value, or no price and the fiat if it is valued at the market price
+	priced := func(value, amount eupholio.Amount) (*decimal.Big, string) {
+		if value.Currency == "" {
+			return nil, e.fiat
+		}
+		return quo(value.Quantity, amount.Quantity), value.Currency
+	}
+
+	ms, err := eupholio.Movements(tr)
+	if err != nil {
+		return nil, err
+	}
+	var rows []*Row
+	for i := 0; i < len(ms); i++ {
+		m := ms[i]
+		switch m.Type {
+		case eupholio.MovementTrade:
+			rows = append(rows, tradeRows(m, e.fiat, newRow)...)
+		case eupholio.MovementIncome:
+			price, counter := priced(m.Value, m.Received)
+			rows = append(rows, newRow(incomeAction(m.Label), m.Received.Currency, m.Received.Quantity, price, counter, nil, ""))
+		case eupholio.MovementFee:
+			// mining cost is followed by the mined income
+			if m.Label == eupholio.LabelCost && i+1 < len(ms) && ms[i+1].Type == eupholio.MovementIncome && ms[i+1].Label == eupholio.LabelMining {
+				cost, mined := m.Sent, ms[i+1]
+				price, counter := priced(mined.Value, mined.Received)
+				if price != nil && counter != cost.Currency {
+					return nil, fmt.Errorf("mining value in %s is paid in %s", counter, cost.Currency)
+				}
+				rows = append(rows, newRow(ActionMining, mined.Received.Currency, mined.Received.Quantity, price, cost.Currency, cost.Quantity, cost.Currency))
+				i++
+				continue
+			}
+			price, counter := priced(m.Value, m.Sent)
+			rows = append(rows, newRow(ActionSendFee, m.Sent.Currency, m.Sent.Quantity, price, counter, nil, m.Sent.Currency))
+		case eupholio.MovementDeposit, eupholio.MovementWithdraw:
+		default:
+			return nil, fmt.Errorf("unknown movement type %s", m.Type)
+		}
+	}
+	return rows, nil
+}
+
+// tradeRows exports a trade as BUY, which is paid in the sent currency, or as SELL if the fiat is received.
+// The fee is exported as SENDFEE if it is paid in neither currency of the trade.
+func tradeRows(m *eupholio.Movement, fiat string, newRow func(string, string, *decimal.Big, *decimal.Big, string, *decimal.Big, string) *Row) []*Row {
+	s, r := m.Sent, m.Received
+	fee, feeCcy := decimal.New(0, 0), ""
+	var feeRows []*Row
+	switch {
+	case m.Fee.IsZero():
+	case m.Fee.Currency == s.Currency || m.Fee.Currency == r.Currency:
+		fee, feeCcy = m.Fee.Quantity, m.Fee.Currency
+	default:
+		feeRows = append(feeRows, newRow(ActionSendFee, m.Fee.Currency, m.Fee.Quantity, nil, fiat, nil, m.Fee.Currency))
+	}
+	if r.Currency == fiat && s.Currency != fiat {
+		return append([]*Row{newRow(ActionSell, s.Currency, s.Quantity, quo(r.Quantity, s.Quantity), r.Currency, fee, feeCcy)}, feeRows...)
+	}
+	return append([]*Row{newRow(ActionBuy, r.Currency, r.Quantity, quo(s.Quantity, r.Quantity), s.Currency, fee, feeCcy)}, feeRows...)
+}
+
+// countTransfers returns the number of deposit and withdrawal events, which are omitted from rows
+func countTransfers(tr *eupholio.EventsOfTransaction) int {
+	n := 0
+	for _, ev := range tr.Events {
+		if ev.Type == eupholio.EventTypeDeposit || ev.Type == eupholio.EventTypeWithdraw {
+			n++
+		}
+	}
+	return n
+}
+
+func incomeAction(label string) string {
+	switch label {
+	case eupholio.LabelLending:
+		return ActionLending
+	case eupholio.LabelStaking:
+		return ActionStaking
+	}
+	return ActionBonus
+}
+
+// quo divides in 34 digits, so that prices are multiplied back to the same quantities by Translator
+func quo(x, y *decimal.Big) *decimal.Big {
+	if y.Sign() == 0 {
+		return decimal.New(0, 0)
+	}
+	return decimal.Context128.Quo(new(decimal.Big), x, y)
+}
@@ -0,0 +1,139 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package cryptact
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/currency"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+func translateAll(t *testing.T, customs models.CryptactCustomSlice) ([]*eupholio.EventsOfTransaction, models.EventSlice) {
+	translator := NewTranslator(nil, currency.JPY)
+	var trs []*eupholio.EventsOfTransaction
+	var all models.EventSlice
+	for i, c := range customs {
+		tr := &models.Transaction{ID: i + 1}
+		events, desc, err := translator.TranslateTransaction(context.Background(), nil, tr, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		trs = append(trs, &eupholio.EventsOfTransaction{
+			ID:          tr.ID,
+			Time:        c.Timestamp,
+			WalletCode:  c.Source,
+			Events:      events,
+			Description: desc,
+		})
+		all = append(all, events...)
+	}
+	return trs, all
+}
+
+func TestExportRoundTrip(t *testing.T) {
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	customs, err := NewExtractor(jst).extract(strings.NewReader(testCustomCsv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	trs, expected := translateAll(t, customs)
+
+	var buf bytes.Buffer
+	skipped, err := NewExporter("JPY", jst).Export(&buf, trs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if skipped != 0 {
+		t.Fatalf("%d transactions skipped", skipped)
+	}
+
+	customs2, err := NewExtractor(jst).extract(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, actual := translateAll(t, customs2)
+
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d events but %d", len(expected), len(actual))
+	}
+	for i, e := range expected {
+		a := actual[i]
+		if a.Type != e.Type || a.Currency != e.Currency || a.BaseCurrency != e.BaseCurrency ||
+			a.Quantity.Big.Cmp(e.Quantity.Big) != 0 || a.BaseQuantity.Big.Cmp(e.BaseQuantity.Big) != 0 || !a.Time.Equal(e.Time) {
+			t.Errorf("event %d: expected %s %s %s %s %s but %s %s %s %s %s", i,
+				e.Type, e.Currency, e.Quantity.Big, e.BaseCurrency, e.BaseQuantity.Big,
+				a.Type, a.Currency, a.Quantity.Big, a.BaseCurrency, a.BaseQuantity.Big)
+		}
+	}
+}
+
+func TestExportTransfers(t *testing.T) {
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	tm := time.Date(2020, 1, 2, 10, 0, 0, 0, jst)
+	newEvent := eupholio.NewEventFunc(tm, 1)
+	zero := decimal.New(0, 0)
+	fee := decimal.New(1, 4)
+	trs := []*eupholio.EventsOfTransaction{
+		{ID: 1, Time: tm, WalletCode: "BF", Description: "sent BTC", Events: models.EventSlice{
+			newEvent(eupholio.EventTypeWithdraw, "BTC", decimal.New(1, 1), "JPY", zero),
+			newEvent(eupholio.EventTypeFee, "BTC", fee, "BTC", fee),
+		}},
+		{ID: 2, Time: tm, WalletCode: "BF", Description: "deposit JPY", Events: models.EventSlice{
+			newEvent(eupholio.EventTypeDeposit, "JPY", decimal.New(10000, 0), "JPY", zero),
+		}},
+	}
+
+	var buf bytes.Buffer
+	skipped, err := NewExporter("JPY", jst).Export(&buf, trs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if skipped != 2 {
+		t.Errorf("expected 2 skipped transactions but %d", skipped)
+	}
+	customs, err := NewExtractor(jst).extract(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(customs) != 1 || customs[0].Action != ActionSendFee {
+		t.Errorf("expected only the fee to be exported but %d rows", len(customs))
+	}
+}
+
+var testCustomCsv = `Timestamp,Action,Source,Base,Volume,Price,Counter,Fee,FeeCcy,Comment
+2020/1/2 10:00:00,BUY,bitFlyer,BTC,0.123456789,1234567.891,JPY,100,JPY,
+2020/1/3 10:00:00,SELL,bitFlyer,BTC,0.05,900000,JPY,0.0001,BTC,
+2020/1/4 10:00:00,BONUS,bitFlyer,BTC,0.001,850000,JPY,0,JPY,
+2020/1/5 10:00:00,STAKING,Wallet,ETH,0.5,,JPY,0,JPY,
+2020/1/6 10:00:00,MINING,Pool,ETH,0.2,20000,JPY,500,JPY,
+2020/1/7 10:00:00,SENDFEE,bitFlyer,BTC,0.0005,,JPY,0,BTC,
+2020/1/8 10:00:00,TIP,Wallet,ETH,0.01,20000,JPY,0,JPY,
+2020/1/9 10:00:00,REDUCE,Wallet,ETH,0.02,,JPY,0,JPY,
+2020/1/10 10:00:00,LEND,Wallet,ETH,1,,JPY,0.001,ETH,
+`
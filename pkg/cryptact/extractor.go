@@ -38,34 +38,6 @@ import (
 
 const recordTimeFormat = "2006/1/2 15:04:05"
 
-type ErrorWithLineInfo struct {
-	line   int
-	column string
-	err    error
-}
-
-func (e *ErrorWithLineInfo) Error() string {
-	if len(e.column) == 0 {
-		return fmt.Sprintf("line %d: %s", e.line, e.err.Error())
-	}
-	return fmt.Sprintf("line %d(%s): %s", e.line, e.column, e.err.Error())
-}
-
-func errorWithLineNumber(n int, err error) error {
-	return &ErrorWithLineInfo{
-		line: n,
-		err:  err,
-	}
-}
-
-func errorWithLineNumberAndColumn(n int, column string, err error) error {
-	return &ErrorWithLineInfo{
-		line:   n,
-		column: column,
-		err:    err,
-	}
-}
-
 // Extractor for Poloniex trades
 type Extractor struct {
 	loc *time.Location
@@ -86,11 +58,14 @@ func (e *Extractor) Execute(ctx context.Context, db boil.ContextExecutor, reader
 	}
 
 	trades, err := e.extract(reader)
+	if errs, ok := err.(eupholio.RowErrors); ok {
+		err = config.HandleRowErrors(errs)
+	}
 	if err != nil {
 		return err
 	}
 	for _, tr := range trades {
-		err := tr.Insert(ctx, db, boil.Infer())
+		err := config.InsertRow(ctx, db, models.TableNames.CryptactCustom, tr)
 		if err != nil {
 			if config.Debug {
 				log.Print(tr)
@@ -106,28 +81,33 @@ func (e *Extractor) Execute(ctx context.Context, db boil.ContextExecutor, reader
 func (e *Extractor) extract(reader io.Reader) (models.CryptactCustomSlice, error) {
 	rows, err := extractRecords(reader)
 	if err != nil {
-		return nil, errorWithLineNumber(1, err)
+		return nil, &eupholio.RowError{Line: 1, Err: err}
 	}
 
 	var customs models.CryptactCustomSlice
+	var errs eupholio.RowErrors
 
 	for i, row := range rows {
 		n := i + 2
 		timestamp, err := row.Timestamp(e.loc)
 		if err != nil {
-			return nil, errorWithLineNumber(n, err)
+			errs = append(errs, eupholio.NewRowError(n, err))
+			continue
 		}
 		volume, err := row.GetAsDecimal(VolumeColumn)
 		if err != nil {
-			return nil, errorWithLineNumber(n, err)
+			errs = append(errs, eupholio.NewRowError(n, err))
+			continue
 		}
 		price, err := row.GetAsNullDecimal(PriceColumn)
 		if err != nil {
-			return nil, errorWithLineNumber(n, err)
+			errs = append(errs, eupholio.NewRowError(n, err))
+			continue
 		}
 		fee, err := row.GetAsDecimal(FeeColumn)
 		if err != nil {
-			return nil, errorWithLineNumber(n, err)
+			errs = append(errs, eupholio.NewRowError(n, err))
+			continue
 		}
 		custom := &models.CryptactCustom{
 			Timestamp: timestamp,
@@ -143,6 +123,9 @@ func (e *Extractor) extract(reader io.Reader) (models.CryptactCustomSlice, error
 		customs = append(customs, custom)
 	}
 
+	if len(errs) > 0 {
+		return customs, errs
+	}
 	return customs, nil
 }
 
@@ -210,7 +193,11 @@ func (r Record) Get(name string) string {
 
 func (r Record) Timestamp(loc *time.Location) (time.Time, error) {
 	date := r.Get(TimestampColumn)
-	return time.ParseInLocation(recordTimeFormat, date, loc)
+	t, err := time.ParseInLocation(recordTimeFormat, date, loc)
+	if err != nil {
+		return time.Time{}, &eupholio.ColumnError{Column: TimestampColumn, Err: err}
+	}
+	return t, nil
 }
 
 func (r Record) GetAsDecimal(name string) (*decimal.Big, error) {
@@ -218,7 +205,7 @@ func (r Record) GetAsDecimal(name string) (*decimal.Big, error) {
 	if b, ok := new(decimal.Big).SetString(s); ok {
 		return b, nil
 	} else {
-		return nil, fmt.Errorf("invalid decimal '%s' in %s column", s, name)
+		return nil, &eupholio.ColumnError{Column: name, Err: fmt.Errorf("invalid decimal '%s'", s)}
 	}
 }
 
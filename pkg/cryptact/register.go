@@ -0,0 +1,56 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package cryptact
+
+import (
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/currency"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+func init() {
+	eupholio.RegisterExchange(&eupholio.Exchange{
+		Name:        "cryptact",
+		DisplayName: "cryptact",
+		FileTypes: []*eupholio.FileType{
+			{
+				Name:      "custom",
+				Match:     eupholio.CSVMatch(ValidateColumnNames),
+				Extractor: func(loc *time.Location) eupholio.Extractor { return NewExtractor(loc) },
+			},
+		},
+		DefaultFileType: "custom",
+		Timezone:        true,
+		Translator: func(db boil.ContextExecutor, fiat currency.Symbol) eupholio.Translator {
+			return NewTranslator(NewRepository(db), fiat)
+		},
+		RawTables: []eupholio.RawTable{
+			{Name: models.TableNames.CryptactCustom, TimeColumn: "timestamp"},
+		},
+		WalletCodes: map[string]string{
+			WalletCode: "CTc",
+		},
+	})
+}
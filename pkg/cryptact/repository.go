@@ -30,9 +30,10 @@ import (
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 
 	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/eupholio"
 )
 
-const timeFormat = "2006/01/02 15:04:05"
+const timeFormat = "2006-01-02 15:04:05"
 
 type Repository interface {
 	FindCustoms(ctx context.Context, start, end time.Time) (models.CryptactCustomSlice, error)
@@ -49,9 +50,10 @@ type repository struct {
 }
 
 func (r *repository) FindCustoms(ctx context.Context, start, end time.Time) (models.CryptactCustomSlice, error) {
-	s := start.Format(timeFormat)
-	e := end.Format(timeFormat)
+	s := start.UTC().Format(timeFormat)
+	e := end.UTC().Format(timeFormat)
 	ts, err := models.CryptactCustoms(
+		eupholio.InPortfolio(ctx),
 		qm.Where("timestamp >= ? AND timestamp < ?", s, e),
 		qm.OrderBy("timestamp ASC"),
 	).All(ctx, r.db)
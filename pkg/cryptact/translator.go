@@ -25,10 +25,10 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/ericlagergren/decimal"
-	"github.com/volatiletech/sqlboiler/v4/boil"
 
 	"github.com/eupholio/eupholio/models"
 	"github.com/eupholio/eupholio/pkg/currency"
@@ -37,19 +37,21 @@ import (
 
 // Translator is a translator for BitTrex
 type Translator struct {
+	repository   Repository
 	mainCurrency currency.Symbol
 }
 
 // NewTranslator create a translator for BitTrex
-func NewTranslator(mainCurrency currency.Symbol) *Translator {
+func NewTranslator(repo Repository, mainCurrency currency.Symbol) *Translator {
 	return &Translator{
+		repository:   repo,
 		mainCurrency: mainCurrency,
 	}
 }
 
 // Translate stores extracted transaction data to transaction table
 func (t *Translator) Translate(ctx context.Context, repo eupholio.Repository, start, end time.Time) error {
-	cryptactRepository := NewRepository(repo)
+	cryptactRepository := t.repository
 
 	n, err := repo.DeleteTransaction(ctx, WalletCode, start, end)
 	if err != nil {
@@ -70,7 +72,7 @@ func (t *Translator) Translate(ctx context.Context, repo eupholio.Repository, st
 	var events []*models.Event
 
 	for _, custom := range customs {
-		transaction, err := repo.CreateTransaction(ctx, custom.Timestamp, WalletCode, custom.ID)
+		transaction, err := repo.CreateTransaction(ctx, custom.Timestamp, WalletCode, custom.Account, custom.ID)
 		if err != nil {
 			return err
 		}
@@ -80,7 +82,7 @@ func (t *Translator) Translate(ctx context.Context, repo eupholio.Repository, st
 			return err
 		}
 		transaction.Description = desc
-		if _, err := transaction.Update(ctx, repo, boil.Infer()); err != nil {
+		if err := repo.UpdateTransaction(ctx, transaction); err != nil {
 			return err
 		}
 
@@ -128,9 +130,24 @@ func (t *Translator) TranslateTransaction(ctx context.Context, repository euphol
 		paymentQuantity = mul(price, tradingQuantity)
 	}
 
+	// zero values are in the main currency, since the counter currency may be left blank without a price
+	fiat := t.mainCurrency.String()
+
+	// value in counter currency, or in base currency which is valued at the market price later
+	valueCurrency, valueQuantity := paymentCurrency, paymentQuantity
+	if price == nil {
+		valueCurrency, valueQuantity = tradingCurrency, tradingQuantity
+	}
+
 	// fee
 	feeCurrency := tr.FeeCcy
 	feeQuantity := tr.Fee.Big
+	fees := func() []*models.Event {
+		if feeQuantity.Sign() == 0 {
+			return nil
+		}
+		return []*models.Event{newEvent(eupholio.EventTypeFee, feeCurrency, feeQuantity, feeCurrency, feeQuantity)}
+	}
 
 	var events []*models.Event
 	switch tr.Action {
@@ -234,15 +251,30 @@ func (t *Translator) TranslateTransaction(ctx context.Context, repository euphol
 			fee := newEvent(eupholio.EventTypeFee, tradingCurrency, tradingQuantity, paymentCurrency, paymentQuantity) // fee
 			events = append(events, fee)
 		}
-	case ActionTip:
-	case ActionReduce:
-	case ActionBonus:
-		buy := newEvent(eupholio.EventTypeBuy, tradingCurrency, tradingQuantity, paymentCurrency, paymentQuantity) // payment is 0 if price is 0
-		events = append(events, buy)
-	case ActionLending:
-		buy := newEvent(eupholio.EventTypeBuy, tradingCurrency, tradingQuantity, paymentCurrency, paymentQuantity) // payment is 0 if price is 0
-		events = append(events, buy)
-	case ActionStaking:
+	case ActionTip: // gift outflow valued at the price (or the market price)
+		tip := newEvent(eupholio.EventTypeFee, tradingCurrency, tradingQuantity, valueCurrency, valueQuantity)
+		events = append(events, tip)
+		events = append(events, fees()...)
+		desc += fmt.Sprintf("tip %s", tradingCurrency)
+	case ActionReduce: // disposal without proceeds
+		reduce := newEvent(eupholio.EventTypeFee, tradingCurrency, tradingQuantity, fiat, decimal.New(0, 0))
+		events = append(events, reduce)
+		events = append(events, fees()...)
+		desc += fmt.Sprintf("reduce %s", tradingCurrency)
+	case ActionBonus, ActionLending, ActionStaking: // income valued at the price (or the market price)
+		buy := newEvent(eupholio.EventTypeBuy, tradingCurrency, tradingQuantity, fiat, decimal.New(0, 0))        // acquisition without cost
+		sell := newEvent(eupholio.EventTypeSell, tradingCurrency, tradingQuantity, valueCurrency, valueQuantity) // earning
+		buy2 := newEvent(eupholio.EventTypeBuy, tradingCurrency, tradingQuantity, valueCurrency, valueQuantity)  // income
+		events = append(events, buy, sell, buy2)
+		events = append(events, fees()...)
+		desc += fmt.Sprintf("%s %s", strings.ToLower(tr.Action), tradingCurrency)
+	case ActionDefiFee: // fee paid for DeFi transactions
+		fee := newEvent(eupholio.EventTypeFee, tradingCurrency, tradingQuantity, valueCurrency, valueQuantity)
+		events = append(events, fee)
+		desc += fmt.Sprintf("defi fee %s", tradingCurrency)
+	case ActionLend, ActionRecover, ActionBorrow, ActionReturn: // position is kept while lent, and borrowed assets are not owned
+		desc += fmt.Sprintf("%s %s", strings.ToLower(tr.Action), tradingCurrency)
+		return fees(), desc, nil
 	default:
 		return nil, "", fmt.Errorf("unknown action %s", tr.Action)
 	}
@@ -0,0 +1,122 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package cryptact
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/currency"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+func TestTranslateActions(t *testing.T) {
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	customs, err := NewExtractor(jst).extract(strings.NewReader(testActionsCsv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dec := func(s string) *decimal.Big {
+		d, _ := new(decimal.Big).SetString(s)
+		return d
+	}
+	type event struct {
+		typ, currency, quantity, baseCurrency, baseQuantity string
+	}
+	expected := [][]event{
+		{ // TIP
+			{eupholio.EventTypeFee, "ETH", "0.01", "JPY", "200"},
+		},
+		{ // REDUCE (without the counter currency)
+			{eupholio.EventTypeFee, "ETH", "0.02", "JPY", "0"},
+			{eupholio.EventTypeFee, "ETH", "0.001", "ETH", "0.001"},
+		},
+		{ // BONUS
+			{eupholio.EventTypeBuy, "BTC", "0.001", "JPY", "0"},
+			{eupholio.EventTypeSell, "BTC", "0.001", "JPY", "850"},
+			{eupholio.EventTypeBuy, "BTC", "0.001", "JPY", "850"},
+		},
+		{ // LENDING (valued at the market price without the counter currency)
+			{eupholio.EventTypeBuy, "ETH", "0.1", "JPY", "0"},
+			{eupholio.EventTypeSell, "ETH", "0.1", "ETH", "0.1"},
+			{eupholio.EventTypeBuy, "ETH", "0.1", "ETH", "0.1"},
+		},
+		{ // STAKING
+			{eupholio.EventTypeBuy, "ETH", "0.5", "JPY", "0"},
+			{eupholio.EventTypeSell, "ETH", "0.5", "JPY", "10000"},
+			{eupholio.EventTypeBuy, "ETH", "0.5", "JPY", "10000"},
+		},
+		{ // DEFIFEE
+			{eupholio.EventTypeFee, "ETH", "0.005", "ETH", "0.005"},
+		},
+		{}, // LEND
+		{ // RECOVER
+			{eupholio.EventTypeFee, "ETH", "0.001", "ETH", "0.001"},
+		},
+		{}, // BORROW
+		{ // RETURN
+			{eupholio.EventTypeFee, "USDT", "1", "USDT", "1"},
+		},
+	}
+	if len(customs) != len(expected) {
+		t.Fatalf("expected %d rows but %d", len(expected), len(customs))
+	}
+	translator := NewTranslator(nil, currency.JPY)
+	for i, c := range customs {
+		events, desc, err := translator.TranslateTransaction(context.Background(), nil, &models.Transaction{ID: i + 1}, c)
+		if err != nil {
+			t.Fatal(c.Action, err)
+		}
+		if !strings.HasPrefix(desc, strings.ToLower(c.Action)) && !(c.Action == ActionDefiFee && strings.HasPrefix(desc, "defi fee")) {
+			t.Errorf("%s: unexpected description %s", c.Action, desc)
+		}
+		if len(events) != len(expected[i]) {
+			t.Errorf("%s: expected %d events but %d", c.Action, len(expected[i]), len(events))
+			continue
+		}
+		for j, e := range expected[i] {
+			a := events[j]
+			if a.Type != e.typ || a.Currency != e.currency || a.Quantity.Big.Cmp(dec(e.quantity)) != 0 ||
+				a.BaseCurrency != e.baseCurrency || a.BaseQuantity.Big.Cmp(dec(e.baseQuantity)) != 0 {
+				t.Errorf("%s event %d: expected %v but %s %s %s %s %s", c.Action, j, e,
+					a.Type, a.Currency, a.Quantity.Big, a.BaseCurrency, a.BaseQuantity.Big)
+			}
+		}
+	}
+}
+
+var testActionsCsv = `Timestamp,Action,Source,Base,Volume,Price,Counter,Fee,FeeCcy,Comment
+2020/1/8 10:00:00,TIP,Wallet,ETH,0.01,20000,JPY,0,JPY,
+2020/1/9 10:00:00,REDUCE,Wallet,ETH,0.02,,,0.001,ETH,
+2020/1/10 10:00:00,BONUS,bitFlyer,BTC,0.001,850000,JPY,0,JPY,
+2020/1/11 10:00:00,LENDING,Wallet,ETH,0.1,,,0,ETH,
+2020/1/12 10:00:00,STAKING,Wallet,ETH,0.5,20000,JPY,0,JPY,
+2020/1/13 10:00:00,DEFIFEE,Wallet,ETH,0.005,,JPY,0,JPY,
+2020/1/14 10:00:00,LEND,Wallet,ETH,1,,JPY,0,JPY,
+2020/1/15 10:00:00,RECOVER,Wallet,ETH,1,,JPY,0.001,ETH,
+2020/1/16 10:00:00,BORROW,Wallet,USDT,100,,JPY,0,JPY,
+2020/1/17 10:00:00,RETURN,Wallet,USDT,100,,JPY,1,USDT,
+`
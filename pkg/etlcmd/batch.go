@@ -0,0 +1,114 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package etlcmd
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/currency"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+	"github.com/eupholio/eupholio/pkg/repository"
+)
+
+// UndoImportBatch deletes rows imported in a batch of the portfolio of ctx and the batch. Transactions of the
+// exchanges are translated again for the years of the deleted rows, and calculations of the years and the later
+// ones are invalidated, so that the deleted rows are not left in events and balances.
+func UndoImportBatch(ctx context.Context, tx *sql.Tx, id int, loc *time.Location, fiat currency.Symbol) error {
+	batch, err := models.FindImportBatch(ctx, tx, id)
+	if err == nil && batch.PortfolioID != eupholio.PortfolioID(ctx) {
+		err = sql.ErrNoRows
+	}
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("batch %d not found", id)
+	} else if err != nil {
+		return err
+	}
+
+	repo := repository.New(tx, fiat)
+	var total int64
+	var firstYear int
+	for _, e := range eupholio.Exchanges() {
+		var first, last time.Time
+		for _, table := range e.RawTables {
+			s, l, err := findRowTimes(ctx, tx, table, "batch_id = ?", id)
+			if err != nil {
+				return err
+			}
+			if s.IsZero() {
+				continue
+			}
+			if first.IsZero() || s.Before(first) {
+				first = s
+			}
+			if l.After(last) {
+				last = l
+			}
+
+			result, err := tx.ExecContext(ctx, fmt.Sprintf("DELETE FROM `%s` WHERE batch_id = ?", table.Name), id)
+			if err != nil {
+				return err
+			}
+			n, err := result.RowsAffected()
+			if err != nil {
+				return err
+			}
+			if n > 0 {
+				log.Println(n, "records deleted from", table.Name)
+			}
+			total += n
+		}
+		if first.IsZero() || e.Translator == nil {
+			continue
+		}
+
+		start := time.Date(first.In(loc).Year(), time.January, 1, 0, 0, 0, 0, loc)
+		end := time.Date(last.In(loc).Year()+1, time.January, 1, 0, 0, 0, 0, loc)
+		log.Printf("translate %s from %d to %d", e.Name, start.Year(), end.Year()-1)
+		if err := e.Translator(tx, fiat).Translate(ctx, repo, start, end); err != nil {
+			return err
+		}
+		if firstYear == 0 || start.Year() < firstYear {
+			firstYear = start.Year()
+		}
+	}
+	if firstYear != 0 {
+		n, err := models.CalculationYears(eupholio.InPortfolio(ctx), models.CalculationYearWhere.Year.GTE(firstYear)).DeleteAll(ctx, tx)
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			log.Printf("calculations from %d invalidated", firstYear)
+		}
+	}
+	if _, err := models.QuarantinedRows(models.QuarantinedRowWhere.BatchID.EQ(id)).DeleteAll(ctx, tx); err != nil {
+		return err
+	}
+	if _, err := batch.Delete(ctx, tx); err != nil {
+		return err
+	}
+	log.Printf("batch %d (%s) undone, %d records deleted", id, batch.Path, total)
+	return nil
+}
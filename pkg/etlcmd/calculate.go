@@ -22,15 +22,23 @@ package etlcmd
 
 import (
 	"context"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/eupholio/eupholio/models"
 	"github.com/eupholio/eupholio/pkg/costmethod"
 	"github.com/eupholio/eupholio/pkg/costmethod/mam"
 	"github.com/eupholio/eupholio/pkg/costmethod/wam"
 	"github.com/eupholio/eupholio/pkg/currency"
+	"github.com/eupholio/eupholio/pkg/eupholio"
 	"github.com/eupholio/eupholio/pkg/repository"
 )
 
@@ -44,18 +52,11 @@ var CostMethods = []string{
 	CostMethodMovingAverage,
 }
 
-// Calculate updates balance
+// Calculate updates entries and balances of years whose events, prices of them, carry-in balances, cost method or
+// fiat currency have changed since their last calculation. Years whose transactions and market prices are unchanged
+// are skipped without reading their events. Since balances of a year are carried into the next year, changed
+// balances of a recalculated year make the next year recalculated too. A year other than 0 is recalculated anyway.
 func Calculate(ctx context.Context, tx *sql.Tx, year int, fiatCurrency currency.Symbol, loc *time.Location, method string, options ...costmethod.Option) error {
-	var years []int
-	if year == 0 {
-		now := time.Now()
-		for i := 2008; i <= now.Year(); i++ {
-			years = append(years, i)
-		}
-	} else {
-		years = append(years, year)
-	}
-
 	if err := currency.InitSymbols(ctx, tx); err != nil {
 		return err
 	}
@@ -67,11 +68,46 @@ func Calculate(ctx context.Context, tx *sql.Tx, year int, fiatCurrency currency.
 		CostMethodMovingAverage:   mam.NewCalculator(),
 	}
 
-	for _, y := range years {
-		err := costmethod.CalculateFiatPrice(ctx, repo, y, loc, fiatCurrency)
-		if err != nil {
-			return err
+	states, err := models.CalculationYears(eupholio.InPortfolio(ctx)).All(ctx, tx)
+	if err != nil {
+		return err
+	}
+	lastStates := make(map[int]*models.CalculationYear)
+	for _, state := range states {
+		lastStates[state.Year] = state
+	}
+	digests, err := transactionsDigests(ctx, tx, loc)
+	if err != nil {
+		return err
+	}
+	watermark, err := pricesWatermark(ctx, tx, fiatCurrency)
+	if err != nil {
+		return err
+	}
+
+	// years before the first year of transactions and calculations have nothing to calculate
+	firstYear := year
+	for y := range digests {
+		if firstYear == 0 || y < firstYear {
+			firstYear = y
+		}
+	}
+	for y := range lastStates {
+		if firstYear == 0 || y < firstYear {
+			firstYear = y
 		}
+	}
+	lastYear := time.Now().Year()
+	if year > lastYear {
+		lastYear = year
+	}
+	if firstYear == 0 {
+		firstYear = lastYear + 1
+	}
+
+	calculated := 0
+	carryIn := balancesFingerprint(nil)
+	for y := firstYear; y <= lastYear; y++ {
 		config, err := repo.FindConfigByYear(ctx, y)
 		if err != nil {
 			return err
@@ -80,16 +116,178 @@ func Calculate(ctx context.Context, tx *sql.Tx, year int, fiatCurrency currency.
 		if method != "" {
 			m = method
 		}
+		state := &models.CalculationYear{
+			PortfolioID:        eupholio.PortfolioID(ctx),
+			Year:               y,
+			Method:             m,
+			Fiat:               fiatCurrency.String(),
+			CarryInFingerprint: carryIn,
+			TransactionsDigest: digests[y],
+			PricesWatermark:    watermark,
+		}
+		if digests[y] == "" {
+			state.TransactionsDigest = fingerprint(nil)
+		}
+		last, exists := lastStates[y]
+		if exists && y != year && yearUnchanged(last, state) {
+			carryIn = last.BalancesFingerprint
+			continue
+		}
+
+		transactions, err := eupholio.FindEventsOfTransactions(ctx, repo, y, loc)
+		if err != nil {
+			return err
+		}
+		state.EventsFingerprint = eventsFingerprint(transactions, pricesUsed(ctx, repo, fiatCurrency, transactions)...)
+		if exists && y != year && yearUpToDate(last, state) {
+			// transactions are translated again or prices are added, but the inputs are the same
+			last.TransactionsDigest = state.TransactionsDigest
+			last.PricesWatermark = state.PricesWatermark
+			if err := save(ctx, tx, last, true); err != nil {
+				return err
+			}
+			carryIn = last.BalancesFingerprint
+			continue
+		}
 
 		log.Printf("calculate %d using %s", y, m)
 		calc, ok := calcs[m]
 		if !ok {
 			return fmt.Errorf("no cost calcuration method found")
 		}
+		err = costmethod.CalculateFiatPrice(ctx, repo, y, loc, fiatCurrency)
+		if err != nil {
+			return err
+		}
 		err = costmethod.UpdateBalanceByYear(ctx, repo, y, loc, fiatCurrency, calc, options...)
 		if err != nil {
 			return err
 		}
+		balances, err := repo.FindBalancesByYear(ctx, y)
+		if err != nil {
+			return err
+		}
+		state.BalancesFingerprint = balancesFingerprint(balances)
+		state.CalculatedAt = time.Now()
+		if err := save(ctx, tx, state, exists); err != nil {
+			return err
+		}
+		carryIn = state.BalancesFingerprint
+		calculated++
+	}
+	if calculated == 0 {
+		log.Println("all years are up to date")
 	}
 	return nil
 }
+
+// yearUnchanged returns whether a year calculated last time has the same transactions, market prices,
+// carry-in balances, cost method and fiat currency, which are compared without reading events
+func yearUnchanged(last, state *models.CalculationYear) bool {
+	return last.Method == state.Method &&
+		last.Fiat == state.Fiat &&
+		last.TransactionsDigest == state.TransactionsDigest &&
+		last.PricesWatermark == state.PricesWatermark &&
+		last.CarryInFingerprint == state.CarryInFingerprint
+}
+
+// yearUpToDate returns whether a year calculated last time has the same inputs
+func yearUpToDate(last, state *models.CalculationYear) bool {
+	return last.Method == state.Method &&
+		last.Fiat == state.Fiat &&
+		last.EventsFingerprint == state.EventsFingerprint &&
+		last.CarryInFingerprint == state.CarryInFingerprint
+}
+
+// transactionsDigests hashes ids and times of transactions of the portfolio of ctx by year. Since transactions
+// are created again by translation, a year translated since the last calculation has a different digest.
+func transactionsDigests(ctx context.Context, tx *sql.Tx, loc *time.Location) (map[int]string, error) {
+	transactions, err := models.Transactions(
+		eupholio.InPortfolio(ctx),
+		qm.Select(models.TransactionColumns.ID, models.TransactionColumns.Time),
+	).All(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	lines := make(map[int][]string)
+	for _, t := range transactions {
+		y := t.Time.In(loc).Year()
+		lines[y] = append(lines[y], fmt.Sprintf("%d %s", t.ID, t.Time.UTC().Format(time.RFC3339)))
+	}
+	digests := make(map[int]string)
+	for y, l := range lines {
+		digests[y] = fingerprint(l)
+	}
+	return digests, nil
+}
+
+// pricesWatermark hashes the number, the last time and the sum of market prices in a fiat currency,
+// and the priority of their sources, which change if prices are loaded or the priority is changed
+func pricesWatermark(ctx context.Context, tx *sql.Tx, fiat currency.Symbol) (string, error) {
+	var count int
+	var last, sum sql.NullString
+	err := tx.QueryRowContext(ctx, "SELECT COUNT(*), MAX(`time`), SUM(price) FROM market_price WHERE base_currency = ?", fiat.String()).Scan(&count, &last, &sum)
+	if err != nil {
+		return "", err
+	}
+	return fingerprint([]string{
+		fmt.Sprintf("%d %s %s", count, last.String, sum.String),
+		"sources " + strings.Join(eupholio.DefaultsOf(ctx).PriceSources, ","),
+	}), nil
+}
+
+// pricesUsed returns market prices which events are valued at, and the priority of their sources
+func pricesUsed(ctx context.Context, repo eupholio.MarketPriceRepository, fiat currency.Symbol, transactions []*eupholio.EventsOfTransaction) []string {
+	lines := []string{"sources " + strings.Join(eupholio.DefaultsOf(ctx).PriceSources, ",")}
+	found := make(map[string]bool)
+	for _, t := range transactions {
+		for _, e := range t.Events {
+			if e.BaseCurrency == fiat.String() {
+				continue
+			}
+			key := e.BaseCurrency + " " + e.Time.UTC().Format(time.RFC3339Nano)
+			if found[key] {
+				continue
+			}
+			found[key] = true
+			price, err := repo.FindMarketPriceByCurrencyAndTime(ctx, e.BaseCurrency, e.Time)
+			if err != nil {
+				lines = append(lines, "price "+key+" none") // fails in the calculation
+				continue
+			}
+			lines = append(lines, fmt.Sprintf("price %s %s %s %s", key, price.Source, price.Time.UTC().Format(time.RFC3339), price.Price.String()))
+		}
+	}
+	return lines
+}
+
+// eventsFingerprint hashes contents of events, which don't depend on ids given by translation, and other lines
+// such as prices of the events
+func eventsFingerprint(transactions []*eupholio.EventsOfTransaction, others ...string) string {
+	lines := append([]string(nil), others...)
+	for _, t := range transactions {
+		for i, e := range t.Events {
+			lines = append(lines, fmt.Sprintf("%s %s %d %s %s %s %s %s %s",
+				t.Time.UTC().Format(time.RFC3339), t.WalletCode, i, e.Time.UTC().Format(time.RFC3339),
+				e.Type, e.Currency, e.Quantity.String(), e.BaseCurrency, e.BaseQuantity.String()))
+		}
+	}
+	return fingerprint(lines)
+}
+
+// balancesFingerprint hashes balances of a year, which are carried into the next year
+func balancesFingerprint(balances models.BalanceSlice) string {
+	var lines []string
+	for _, b := range balances {
+		lines = append(lines, fmt.Sprintf("%s %s %s %s %s %s %s",
+			b.Currency, b.BeginningQuantity.String(), b.OpenQuantity.String(), b.CloseQuantity.String(),
+			b.Price.String(), b.Quantity.String(), b.Profit.String()))
+	}
+	return fingerprint(lines)
+}
+
+func fingerprint(lines []string) string {
+	sort.Strings(lines)
+	sum := sha256.Sum256([]byte(strings.Join(lines, "\n")))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,112 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package etlcmd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/currency"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+	"github.com/eupholio/eupholio/pkg/repository/memory"
+)
+
+func newBalance(currency string, quantity int64) *models.Balance {
+	d := func(v int64) types.Decimal { return types.NewDecimal(decimal.New(v, 0)) }
+	return &models.Balance{
+		Year:              2020,
+		Currency:          currency,
+		BeginningQuantity: d(0),
+		OpenQuantity:      d(quantity),
+		CloseQuantity:     d(0),
+		Price:             d(100),
+		Quantity:          d(quantity),
+		Profit:            d(0),
+	}
+}
+
+func TestBalancesFingerprint(t *testing.T) {
+	a := balancesFingerprint(models.BalanceSlice{newBalance("BTC", 1), newBalance("ETH", 2)})
+	b := balancesFingerprint(models.BalanceSlice{newBalance("ETH", 2), newBalance("BTC", 1)})
+	if a != b {
+		t.Error("fingerprint must not depend on the order of balances")
+	}
+	if a == balancesFingerprint(models.BalanceSlice{newBalance("BTC", 1), newBalance("ETH", 3)}) {
+		t.Error("fingerprint must change with quantities")
+	}
+}
+
+func TestEventsFingerprint(t *testing.T) {
+	tm := time.Date(2020, time.January, 2, 0, 0, 0, 0, time.UTC)
+	transaction := func(id int, quantity int64) *eupholio.EventsOfTransaction {
+		newEvent := eupholio.NewEventFunc(tm, id)
+		return &eupholio.EventsOfTransaction{
+			ID:         id,
+			Time:       tm,
+			WalletCode: "BITFLYER",
+			Events: models.EventSlice{
+				newEvent(eupholio.EventTypeBuy, "BTC", decimal.New(quantity, 0), "JPY", decimal.New(100, 0)),
+			},
+		}
+	}
+	a := eventsFingerprint([]*eupholio.EventsOfTransaction{transaction(1, 1)})
+	if a != eventsFingerprint([]*eupholio.EventsOfTransaction{transaction(2, 1)}) {
+		t.Error("fingerprint must not depend on ids of translated transactions")
+	}
+	if a == eventsFingerprint([]*eupholio.EventsOfTransaction{transaction(1, 2)}) {
+		t.Error("fingerprint must change with quantities")
+	}
+}
+
+func TestPricesUsed(t *testing.T) {
+	ctx := context.Background()
+	tm := time.Date(2020, time.January, 2, 0, 0, 0, 0, time.UTC)
+	price := func(source string, p int64) *models.MarketPrice {
+		return &models.MarketPrice{Source: source, Currency: "BTC", BaseCurrency: "JPY", Time: tm, Price: types.NewDecimal(decimal.New(p, 0))}
+	}
+	repo := memory.New(currency.JPY)
+	if err := repo.CreateMarketPrices(ctx, models.MarketPriceSlice{price("coingecko", 1000000), price("yahoofinance", 1010000)}); err != nil {
+		t.Fatal(err)
+	}
+	newEvent := eupholio.NewEventFunc(tm, 1)
+	transactions := []*eupholio.EventsOfTransaction{{
+		ID:         1,
+		Time:       tm,
+		WalletCode: "BITFLYER",
+		Events: models.EventSlice{
+			newEvent(eupholio.EventTypeBuy, "ETH", decimal.New(1, 0), "BTC", decimal.New(3, 2)),
+		},
+	}}
+
+	coingecko := eventsFingerprint(transactions, pricesUsed(eupholio.WithDefaults(ctx, &eupholio.Defaults{PriceSources: []string{"coingecko"}}), repo, currency.JPY, transactions)...)
+	yahoo := eventsFingerprint(transactions, pricesUsed(eupholio.WithDefaults(ctx, &eupholio.Defaults{PriceSources: []string{"yahoofinance"}}), repo, currency.JPY, transactions)...)
+	if coingecko == yahoo {
+		t.Error("fingerprint must change with the priority of price sources")
+	}
+	if coingecko == eventsFingerprint(transactions) {
+		t.Error("fingerprint must change with prices")
+	}
+}
@@ -0,0 +1,85 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package etlcmd
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+// SetCostMethod sets the cost method of a year of the portfolio of ctx
+func SetCostMethod(ctx context.Context, db boil.ContextExecutor, year int, method string) error {
+	id := eupholio.PortfolioID(ctx)
+	c, err := models.FindConfig(ctx, db, id, year)
+	if err == sql.ErrNoRows {
+		c = &models.Config{
+			PortfolioID: id,
+			Year:        year,
+			CostMethod:  method,
+		}
+		return c.Insert(ctx, db, boil.Infer())
+	}
+	if err != nil {
+		return err
+	}
+	c.CostMethod = method
+	_, err = c.Update(ctx, db, boil.Infer())
+	return err
+}
+
+// SetPortfolio creates a portfolio or updates the fiat and the timezone of it.
+// The default portfolio is stored with id 0, which is the portfolio of data imported without portfolios.
+func SetPortfolio(ctx context.Context, db boil.ContextExecutor, name, fiat, timezone string) (*models.Portfolio, error) {
+	if name == "" {
+		name = eupholio.DefaultPortfolioName
+	}
+	p, err := models.Portfolios(models.PortfolioWhere.Name.EQ(name)).One(ctx, db)
+	exists := err == nil
+	if err == sql.ErrNoRows {
+		p = eupholio.NewDefaultPortfolio(ctx)
+		p.Name = name
+		if name != eupholio.DefaultPortfolioName {
+			var maxID sql.NullInt64
+			if err := db.QueryRowContext(ctx, "SELECT MAX(id) FROM portfolios").Scan(&maxID); err != nil {
+				return nil, err
+			}
+			p.ID = int(maxID.Int64) + 1
+		}
+	} else if err != nil {
+		return nil, err
+	}
+	if fiat != "" {
+		p.Fiat = fiat
+	}
+	if timezone != "" {
+		if _, err := time.LoadLocation(timezone); err != nil {
+			return nil, err
+		}
+		p.Timezone = timezone
+	}
+	return p, save(ctx, db, p, exists)
+}
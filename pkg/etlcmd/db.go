@@ -0,0 +1,77 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package etlcmd
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"log"
+	"strconv"
+
+	"github.com/olekukonko/tablewriter"
+
+	"github.com/eupholio/eupholio/pkg/migration"
+)
+
+// MigrateDB applies pending migrations up to the version, or all of them if version is 0
+func MigrateDB(ctx context.Context, db *sql.DB, dialect migration.Dialect, version int) error {
+	applied, err := migration.Migrate(ctx, db, dialect, version)
+	for _, m := range applied {
+		log.Printf("migrated %d %s", m.Version, m.Name)
+	}
+	if err != nil {
+		return err
+	}
+	if len(applied) == 0 {
+		log.Println("database is up to date")
+	}
+	return nil
+}
+
+// RollbackDB rolls back the last applied migrations as many as steps
+func RollbackDB(ctx context.Context, db *sql.DB, dialect migration.Dialect, steps int) error {
+	rolledBack, err := migration.Rollback(ctx, db, dialect, steps)
+	for _, m := range rolledBack {
+		log.Printf("rolled back %d %s", m.Version, m.Name)
+	}
+	return err
+}
+
+// PrintDBStatus shows the migrations and the times they were applied at
+func PrintDBStatus(ctx context.Context, w io.Writer, db *sql.DB) error {
+	states, err := migration.Status(ctx, db)
+	if err != nil {
+		return err
+	}
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{"Version", "Name", "Applied At"})
+	table.SetAutoWrapText(false)
+	for _, s := range states {
+		appliedAt := "pending"
+		if s.Applied() {
+			appliedAt = s.AppliedAt.Format("2006-01-02 15:04:05")
+		}
+		table.Append([]string{strconv.Itoa(s.Version), s.Name, appliedAt})
+	}
+	table.Render()
+	return nil
+}
@@ -0,0 +1,139 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package etlcmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+// sniffSize is the size of the head of a file to detect its type
+const sniffSize = 4096
+
+// ReadHead reads the head of a file. It fails if the file is not encoded in UTF-8.
+func ReadHead(name string, reader io.Reader) (*eupholio.Head, error) {
+	b := make([]byte, sniffSize)
+	n, err := io.ReadFull(reader, b)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return nil, err
+	}
+	b = b[:n]
+
+	head := &eupholio.Head{Name: strings.ToLower(filepath.Base(name))}
+	switch {
+	case bytes.HasPrefix(b, bom):
+		head.BOM = true
+		b = b[3:]
+	case bytes.HasPrefix(b, []byte{0xff, 0xfe}), bytes.HasPrefix(b, []byte{0xfe, 0xff}):
+		return nil, fmt.Errorf("UTF-16 is not supported")
+	}
+	if n == sniffSize {
+		// the last character may be cut off
+		for i := 0; i < utf8.UTFMax && len(b) > 0 && !utf8.Valid(b); i++ {
+			b = b[:len(b)-1]
+		}
+	}
+	if !utf8.Valid(b) {
+		return nil, fmt.Errorf("not encoded in UTF-8 (convert Shift_JIS files to UTF-8)")
+	}
+
+	line := b
+	if i := bytes.IndexByte(b, '\n'); i >= 0 {
+		line = b[:i]
+	}
+	line = bytes.TrimSpace(line)
+	if len(line) == 0 {
+		return nil, fmt.Errorf("empty file")
+	}
+	if line[0] == '{' {
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(line, &object); err != nil {
+			return nil, fmt.Errorf("invalid JSON Lines: %w", err)
+		}
+		head.JSON = []string{}
+		for k := range object {
+			head.JSON = append(head.JSON, k)
+		}
+		sort.Strings(head.JSON)
+		return head, nil
+	}
+	r := csv.NewReader(bytes.NewReader(line))
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+	head.Header, err = r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV: %w", err)
+	}
+	return head, nil
+}
+
+// ImportAutoData detects the types of files and imports them. Nothing is imported unless all files are detected.
+func ImportAutoData(ctx context.Context, db boil.ContextExecutor, args []string, overwrite, lenient bool, location string, account string) error {
+	loc, err := time.LoadLocation(location)
+	if err != nil {
+		return err
+	}
+
+	fileTypes := make([]*eupholio.FileType, len(args))
+	var errs []string
+	for i, arg := range args {
+		ft, err := detectFile(arg, eupholio.FileTypes())
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", arg, err))
+			continue
+		}
+		log.Printf("%s: detected %s", arg, ft.FullName())
+		fileTypes[i] = ft
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%d files cannot be detected:\n%s", len(errs), strings.Join(errs, "\n"))
+	}
+
+	return importFiles(ctx, db, args, fileTypes, overwrite, lenient, account, loc)
+}
+
+func detectFile(path string, fileTypes []*eupholio.FileType) (*eupholio.FileType, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	head, err := ReadHead(path, f)
+	if err != nil {
+		return nil, err
+	}
+	return eupholio.DetectFileType(head, fileTypes)
+}
@@ -0,0 +1,79 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package etlcmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+func TestDetectFileType(t *testing.T) {
+	cases := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{"TradeHistory.csv", "\ufeff" + `"取引日時","通貨","取引種別","取引価格","通貨1","通貨1数量","手数料","通貨1の対円レート","通貨2","通貨2数量","自己・媒介","注文 ID","備考"` + "\n", "bitflyer trade"},
+		{"orders.csv", "Id,MarketSymbol,Direction,Type,Quantity,Limit,Ceiling,TimeInForce,ClientOrderId,FillQuantity,Commission,Proceeds,Status,CreatedAt,UpdatedAt,ClosedAt,OrderToCancel\n", "bittrex order"},
+		{"BittrexDepositHistory.csv", "2017/08/16 10:00:00\nBTC\n0.1\nCompleted\n", "bittrex deposit"},
+		{"koinly.csv", "Date,Sent Amount,Sent Currency,Received Amount,Received Currency,Fee Amount,Fee Currency,Net Worth Amount,Net Worth Currency,Label,Description,TxHash\n", "koinly"},
+		{"trades.csv", `"Type","Buy","Cur.","Sell","Cur.","Fee","Cur.","Exchange","Trade-Group","Comment","Date"` + "\r\n", "cointracking"},
+		{"otc.jsonl", `{"version":1,"id":"otc-1","time":"2020-01-02T10:00:00+09:00","wallet":"OTC","type":"buy","currency":"BTC","quantity":"0.1"}` + "\n", "ledger"},
+	}
+	for _, c := range cases {
+		head, err := ReadHead(c.name, strings.NewReader(c.content))
+		if err != nil {
+			t.Errorf("%s: %v", c.name, err)
+			continue
+		}
+		ft, err := eupholio.DetectFileType(head, eupholio.FileTypes())
+		if err != nil {
+			t.Errorf("%s: %v", c.name, err)
+			continue
+		}
+		if ft.FullName() != c.expected {
+			t.Errorf("%s: expected %s but %s", c.name, c.expected, ft.FullName())
+		}
+	}
+}
+
+func TestDetectFileTypeError(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+		err     string
+	}{
+		{"history.csv", "2017/08/16 10:00:00\nBTC\n0.1\nCompleted\n", "ambiguous"},
+		{"unknown.csv", "a,b,c\n1,2,3\n", "unknown header"},
+		{"sjis.csv", "\x8e\xe6\x88\xf8,\x92\xca\x89\xdd\n", "UTF-8"},
+	}
+	for _, c := range cases {
+		head, err := ReadHead(c.name, strings.NewReader(c.content))
+		if err == nil {
+			_, err = eupholio.DetectFileType(head, eupholio.FileTypes())
+		}
+		if err == nil || !strings.Contains(err.Error(), c.err) {
+			t.Errorf("%s: expected error %q but %v", c.name, c.err, err)
+		}
+	}
+}
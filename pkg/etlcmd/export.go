@@ -0,0 +1,178 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package etlcmd
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"github.com/eupholio/eupholio/pkg/cointracking"
+	"github.com/eupholio/eupholio/pkg/core"
+	"github.com/eupholio/eupholio/pkg/cryptact"
+	"github.com/eupholio/eupholio/pkg/currency"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+	"github.com/eupholio/eupholio/pkg/koinly"
+	"github.com/eupholio/eupholio/pkg/repository"
+)
+
+// findEventsOfTransactions returns events of transactions of a year, or of all years if year is 0
+func findEventsOfTransactions(ctx context.Context, repo eupholio.Repository, year int, loc *time.Location) ([]*eupholio.EventsOfTransaction, error) {
+	var years []int
+	if year == 0 {
+		now := time.Now()
+		for i := 2008; i <= now.Year(); i++ {
+			years = append(years, i)
+		}
+	} else {
+		years = append(years, year)
+	}
+
+	var ret []*eupholio.EventsOfTransaction
+	for _, y := range years {
+		trs, err := eupholio.FindEventsOfTransactions(ctx, repo, y, loc)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, trs...)
+	}
+	return ret, nil
+}
+
+// ExportCryptactData writes the event ledger as a Cryptact custom file
+func ExportCryptactData(ctx context.Context, tx *sql.Tx, w io.Writer, year int, jst *time.Location, fiat currency.Symbol, location string) error {
+	loc, err := time.LoadLocation(location)
+	if err != nil {
+		return err
+	}
+
+	repo := repository.New(tx, fiat)
+	trs, err := findEventsOfTransactions(ctx, repo, year, jst)
+	if err != nil {
+		return err
+	}
+
+	skipped, err := cryptact.NewExporter(fiat.String(), loc).Export(w, trs)
+	if err != nil {
+		return err
+	}
+	if skipped > 0 {
+		log.Println(skipped, "transactions are not exported, or exported without deposits and withdrawals")
+	}
+	return nil
+}
+
+// ExportKoinlyData writes the event ledger as a Koinly universal file
+func ExportKoinlyData(ctx context.Context, tx *sql.Tx, w io.Writer, year int, jst *time.Location, fiat currency.Symbol) error {
+	repo := repository.New(tx, fiat)
+	trs, err := findEventsOfTransactions(ctx, repo, year, jst)
+	if err != nil {
+		return err
+	}
+
+	skipped, err := koinly.NewExporter().Export(w, trs)
+	if err != nil {
+		return err
+	}
+	if skipped > 0 {
+		log.Println(skipped, "transactions are not exported")
+	}
+	return nil
+}
+
+// ExportCointrackingData writes the event ledger as a CoinTracking trade list
+func ExportCointrackingData(ctx context.Context, tx *sql.Tx, w io.Writer, year int, jst *time.Location, fiat currency.Symbol, location string) error {
+	loc, err := time.LoadLocation(location)
+	if err != nil {
+		return err
+	}
+
+	repo := repository.New(tx, fiat)
+	trs, err := findEventsOfTransactions(ctx, repo, year, jst)
+	if err != nil {
+		return err
+	}
+
+	skipped, err := cointracking.NewExporter(loc).Export(w, trs)
+	if err != nil {
+		return err
+	}
+	if skipped > 0 {
+		log.Println(skipped, "transactions are not exported")
+	}
+	return nil
+}
+
+// coreMethods maps cost methods to methods of eupholio-core
+var coreMethods = map[string]string{
+	CostMethodWeightedAverage: core.MethodTotalAverage,
+	CostMethodMovingAverage:   core.MethodMovingAverage,
+}
+
+// BuildCoreInput converts entries of a year to the input of eupholio-core-cli, with the cost method of the year unless specified
+func BuildCoreInput(ctx context.Context, tx *sql.Tx, year int, jst *time.Location, fiat currency.Symbol, method string, splitIncome bool) (*core.Input, error) {
+	if year == 0 {
+		return nil, fmt.Errorf("year is not specified")
+	}
+	repo := repository.New(tx, fiat)
+
+	if method == "" {
+		config, err := repo.FindConfigByYear(ctx, year)
+		if err != nil {
+			return nil, err
+		}
+		method = config.CostMethod
+	}
+	coreMethod, ok := coreMethods[method]
+	if !ok {
+		return nil, fmt.Errorf("unknown cost method %s", method)
+	}
+
+	entries, err := eupholio.FindEntriesOfTransactions(ctx, repo, year, jst)
+	if err != nil {
+		return nil, err
+	}
+	events, err := eupholio.FindEventsOfTransactions(ctx, repo, year, jst)
+	if err != nil {
+		return nil, err
+	}
+	lastBalances, err := repo.FindBalancesByYear(ctx, year-1)
+	if err != nil {
+		return nil, err
+	}
+
+	return core.NewBuilder(fiat.String(), jst, splitIncome).Build(coreMethod, year, entries, events, lastBalances)
+}
+
+// ExportCoreInputData writes entries of a year as the input of eupholio-core-cli
+func ExportCoreInputData(ctx context.Context, tx *sql.Tx, w io.Writer, year int, jst *time.Location, fiat currency.Symbol, method string, splitIncome bool) error {
+	input, err := BuildCoreInput(ctx, tx, year, jst, fiat, method, splitIncome)
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(input)
+}
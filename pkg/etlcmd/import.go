@@ -21,159 +21,184 @@
 package etlcmd
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
 	"fmt"
-	"os"
-	"path/filepath"
-	"strings"
+	"io/ioutil"
+	"log"
 	"time"
 
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 
+	"github.com/eupholio/eupholio/models"
 	"github.com/eupholio/eupholio/pkg/bitflyer"
-	"github.com/eupholio/eupholio/pkg/bittrex"
-	"github.com/eupholio/eupholio/pkg/coincheck"
-	"github.com/eupholio/eupholio/pkg/cryptact"
 	"github.com/eupholio/eupholio/pkg/eupholio"
-	"github.com/eupholio/eupholio/pkg/poloniex"
+	_ "github.com/eupholio/eupholio/pkg/exchanges" // registers exchanges
+	"github.com/eupholio/eupholio/pkg/normalized"
 )
 
-func ImportBitflyerData(ctx context.Context, db boil.ContextExecutor, args []string, overwrite bool) error {
-	executor := bitflyer.NewExecutor()
+var bom = []byte{0xef, 0xbb, 0xbf}
 
+// importOptions returns options of extractors.
+// In lenient mode, rows which cannot be imported are quarantined instead of failing the import.
+// Rows are marked with the account unless it is empty.
+func importOptions(overwrite, lenient bool, account string) []eupholio.Option {
 	var opts []eupholio.Option
 	if overwrite {
 		opts = append(opts, eupholio.OverwriteOption())
 	}
-
-	for _, arg := range args {
-		err := extract(ctx, arg, db, executor, opts)
-		if err != nil {
-			return err
-		}
+	if lenient {
+		opts = append(opts, eupholio.LenientOption())
 	}
-	return nil
+	if account != "" {
+		opts = append(opts, eupholio.AccountOption(account))
+	}
+	return opts
 }
 
-func ImportCoincheckData(ctx context.Context, db boil.ContextExecutor, args []string, overwrite bool) error {
-	executor := coincheck.NewExecutor()
-
-	var opts []eupholio.Option
-	if overwrite {
-		opts = append(opts, eupholio.OverwriteOption())
+// ImportData imports files of an exchange. Unless the file type is specified or defaulted by the exchange,
+// the type of each file is detected by its header and files of unknown types are skipped.
+func ImportData(ctx context.Context, db boil.ContextExecutor, exchange string, args []string, overwrite, lenient bool, filetype string, location string, account string) error {
+	e, ok := eupholio.LookupExchange(exchange)
+	if !ok {
+		return fmt.Errorf("unknown exchange: %s", exchange)
 	}
 
-	for _, arg := range args {
-		err := extract(ctx, arg, db, executor, opts)
-		if err != nil {
-			return err
-		}
+	loc, err := time.LoadLocation(location)
+	if err != nil {
+		return err
 	}
-	return nil
-}
 
-func ImportBittrexData(ctx context.Context, db boil.ContextExecutor, args []string, overwrite bool, filetype string) error {
-	var opts []eupholio.Option
-	if overwrite {
-		opts = append(opts, eupholio.OverwriteOption())
+	if filetype == "" {
+		filetype = e.DefaultFileType
 	}
-
-	var executor eupholio.Extractor
-	switch filetype {
-	case "order":
-		executor = bittrex.NewExtractor()
-	case "deposit":
-		executor = bittrex.NewDepositExtractor()
-	case "withdraw":
-		executor = bittrex.NewWithdrawExtractor()
-	default:
-		return fmt.Errorf("unknown file type: %s", filetype)
+	var ft *eupholio.FileType
+	if filetype != "" || len(e.FileTypes) == 1 {
+		if ft, ok = e.FileType(filetype); !ok {
+			return fmt.Errorf("unknown file type: %s", filetype)
+		}
 	}
 
+	var paths []string
+	var fileTypes []*eupholio.FileType
 	for _, arg := range args {
-		err := extract(ctx, arg, db, executor, opts)
-		if err != nil {
-			return err
+		t := ft
+		if t == nil {
+			if t, err = detectFile(arg, e.FileTypes); err != nil {
+				log.Printf("%s: skipped (%v)", arg, err)
+				continue
+			}
 		}
+		paths = append(paths, arg)
+		fileTypes = append(fileTypes, t)
 	}
-	return nil
+	return importFiles(ctx, db, paths, fileTypes, overwrite, lenient, account, loc)
 }
 
-func ImportPoloniexData(ctx context.Context, db boil.ContextExecutor, args []string, overwrite bool, filetype string) error {
-	var opts []eupholio.Option
-	if overwrite {
-		opts = append(opts, eupholio.OverwriteOption())
+// ImportBitflyerAPIData fetches executions, deposits and withdrawals from bitFlyer API, and stores the ones which are not stored yet
+func ImportBitflyerAPIData(ctx context.Context, db boil.ContextExecutor, baseURL, key, secret string, productCodes []string, account string) error {
+	if key == "" || secret == "" {
+		return fmt.Errorf("api key and secret are required")
 	}
-
-	extractors := map[string]eupholio.Extractor{
-		"trades":        poloniex.NewTradeExtractor(),
-		"deposits":      poloniex.NewDepositExtractor(),
-		"withdrawals":   poloniex.NewWithdrawalExtractor(),
-		"distributions": poloniex.NewDistributionExtractor(),
+	client := bitflyer.NewClient(baseURL, key, secret)
+	n, err := bitflyer.NewAPIExtractor(client, productCodes).Execute(ctx, db, importOptions(false, false, account)...)
+	if err != nil {
+		return err
 	}
+	log.Println("imported", n, "transactions from", client.BaseURL())
+	return nil
+}
 
+// ImportNormalizedData imports files of events normalized by eupholio-normalizer as batches, marking the events with a wallet
+func ImportNormalizedData(ctx context.Context, db boil.ContextExecutor, args []string, overwrite, lenient bool, wallet, account string) error {
+	extractor, err := normalized.NewExtractor(wallet)
+	if err != nil {
+		return err
+	}
 	for _, arg := range args {
-		var executor eupholio.Extractor
-		ft := filetype
-		if len(ft) == 0 {
-			ss := strings.Split(filepath.Base(arg), "-")
-			if len(ss) > 0 {
-				if _, ok := extractors[ss[0]]; ok {
-					ft = ss[0]
-				} else {
-					continue
-				}
-			}
-		}
-		executor, ok := extractors[ft]
-		if !ok {
-			return fmt.Errorf("unknown file type: %s", ft)
-		}
-		err := extract(ctx, arg, db, executor, opts)
-		if err != nil {
+		if err := extract(ctx, arg, db, "normalized", extractor, importOptions(overwrite, lenient, account)); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func ImportCryptactData(ctx context.Context, db boil.ContextExecutor, args []string, overwrite bool, filetype string, location string) error {
-	var opts []eupholio.Option
-	if overwrite {
-		opts = append(opts, eupholio.OverwriteOption())
+// importFiles imports files of the types.
+// overwrite is applied to the first file of each type, since extractors delete all records of the type in the account.
+func importFiles(ctx context.Context, db boil.ContextExecutor, paths []string, fileTypes []*eupholio.FileType, overwrite, lenient bool, account string, loc *time.Location) error {
+	overwritten := make(map[*eupholio.FileType]bool)
+	for i, path := range paths {
+		ft := fileTypes[i]
+		opts := importOptions(overwrite && !overwritten[ft], lenient, account)
+		overwritten[ft] = true
+		if err := extract(ctx, path, db, ft.FullName(), ft.Extractor(loc), opts); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	loc, err := time.LoadLocation(location)
+// extract imports a file as a batch. Files which have been imported to the account of the portfolio are skipped unless overwrite is specified.
+func extract(ctx context.Context, path string, db boil.ContextExecutor, exchange string, extractor eupholio.Extractor, opts []eupholio.Option) error {
+	b, err := ioutil.ReadFile(path)
 	if err != nil {
 		return err
 	}
+	sum := sha256.Sum256(b)
+	digest := hex.EncodeToString(sum[:])
 
-	var executor eupholio.Extractor
-	switch filetype {
-	case "custom":
-		executor = cryptact.NewExtractor(loc)
-	default:
-		return fmt.Errorf("unknown file type: %s", filetype)
+	config := &eupholio.Config{}
+	for _, o := range opts {
+		o(config)
 	}
-
-	for _, arg := range args {
-		err := extract(ctx, arg, db, executor, opts)
-		if err != nil {
+	if !config.Overwrite {
+		imported, err := models.ImportBatches(eupholio.InPortfolio(ctx), qm.Where("sha256 = ? AND account = ?", digest, config.Account)).One(ctx, db)
+		if err != nil && err != sql.ErrNoRows {
 			return err
 		}
+		if imported != nil {
+			log.Printf("%s: already imported as batch %d (%s)", path, imported.ID, imported.Path)
+			return nil
+		}
 	}
-	return nil
-}
 
-func extract(ctx context.Context, path string, db boil.ContextExecutor, extractor eupholio.Extractor, opts []eupholio.Option) error {
-	reader, err := os.Open(path)
-	if err != nil {
+	importBatch := &models.ImportBatch{
+		Path:        path,
+		Sha256:      digest,
+		Exchange:    exchange,
+		PortfolioID: eupholio.PortfolioID(ctx),
+		Account:     config.Account,
+		ImportedAt:  time.Now().UTC(),
+	}
+	if err := importBatch.Insert(ctx, db, boil.Infer()); err != nil {
 		return err
 	}
-	defer reader.Close()
-	err = extractor.Execute(ctx, db, reader, opts...)
+	batch := eupholio.NewBatch(importBatch.ID)
+	err = extractor.Execute(ctx, db, bytes.NewReader(bytes.TrimPrefix(b, bom)), append(opts, eupholio.BatchOption(batch))...)
 	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	for _, rowErr := range batch.Rejected {
+		row := &models.QuarantinedRow{
+			BatchID:    importBatch.ID,
+			Line:       rowErr.Line,
+			ColumnName: rowErr.Column,
+			Reason:     rowErr.Err.Error(),
+		}
+		if err := row.Insert(ctx, db, boil.Infer()); err != nil {
+			return err
+		}
+		log.Printf("%s: quarantined %s", path, rowErr)
+	}
+	importBatch.RowCount = batch.Inserted
+	importBatch.RejectedCount = len(batch.Rejected)
+	if _, err := importBatch.Update(ctx, db, boil.Infer()); err != nil {
 		return err
 	}
+	log.Printf("%s: imported %d rows as batch %d (%d duplicated rows skipped, %d rows quarantined)", path, batch.Inserted, importBatch.ID, batch.Skipped, len(batch.Rejected))
 	return nil
 }
@@ -22,7 +22,6 @@ package etlcmd
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"log"
 	"os"
@@ -59,7 +58,7 @@ func LoadCoingeckoHistoricalPrice(db boil.ContextExecutor, args []string) error
 
 var yahooFinanceHistoricalPriceFilenameRE = regexp.MustCompile("([A-Z]+)-([A-Z]+).csv")
 
-func LoadYahooFinanceHistoricalPrice(db *sql.DB, args []string) error {
+func LoadYahooFinanceHistoricalPrice(db boil.ContextExecutor, args []string) error {
 	ctx := context.Background()
 	for _, arg := range args {
 		filename := filepath.Base(arg)
@@ -81,7 +80,7 @@ func LoadYahooFinanceHistoricalPrice(db *sql.DB, args []string) error {
 
 var cddHistoricalPriceFilenameRE = regexp.MustCompile("(Bittrex|Poloniex)_([A-Z]+)(USD|BTC|ETH)_1h.csv")
 
-func LoadCDDHistoricalPrice(db *sql.DB, args []string) error {
+func LoadCDDHistoricalPrice(db boil.ContextExecutor, args []string) error {
 	ctx := context.Background()
 	for _, arg := range args {
 		filename := filepath.Base(arg)
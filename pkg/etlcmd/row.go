@@ -0,0 +1,43 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package etlcmd
+
+import (
+	"context"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// row is a model generated by sqlboiler
+type row interface {
+	Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error
+	Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error)
+}
+
+// save inserts a row, or updates it if it exists. Upsert of the models is not used
+// because it is written in the syntax of MySQL.
+func save(ctx context.Context, db boil.ContextExecutor, r row, exists bool) error {
+	if exists {
+		_, err := r.Update(ctx, db, boil.Infer())
+		return err
+	}
+	return r.Insert(ctx, db, boil.Infer())
+}
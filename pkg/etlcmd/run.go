@@ -0,0 +1,417 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package etlcmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/cmdutil"
+	"github.com/eupholio/eupholio/pkg/currency"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+	"github.com/eupholio/eupholio/pkg/manifest"
+	"github.com/eupholio/eupholio/pkg/querycmd"
+)
+
+// stage is a stage of a pipeline. A stage is up to date when its inputs and the inputs of
+// all stages before it are unchanged since its last run and its outputs exist.
+type stage struct {
+	name    string
+	inputs  []interface{} // values and files (fileInput) fingerprinted
+	outputs []string
+	run     func(ctx context.Context, tx *sql.Tx) error
+}
+
+// fileInput is a file whose contents are fingerprinted
+type fileInput string
+
+// Run runs the stages of a manifest in order: download, load, import, costmethod, translate, calculate and report.
+// Up-to-date stages are skipped unless force is set.
+func Run(ctx context.Context, db *sql.DB, m *manifest.Manifest, force bool) error {
+	portfolio, err := eupholio.FindPortfolio(ctx, db, m.Portfolio)
+	if err != nil {
+		return err
+	}
+	ctx = eupholio.WithPortfolio(ctx, portfolio)
+	if m.Fiat == "" {
+		m.Fiat = portfolio.Fiat
+	}
+	if m.Timezone == "" {
+		m.Timezone = portfolio.Timezone
+	}
+
+	stages, err := pipelineStages(m)
+	if err != nil {
+		return err
+	}
+
+	var fingerprint string
+	for _, s := range stages {
+		fingerprint, err = stageFingerprint(fingerprint, s)
+		if err != nil {
+			return fmt.Errorf("stage %s: %w", s.name, err)
+		}
+		err = cmdutil.WithTx(ctx, db, func(tx *sql.Tx) error {
+			if !force {
+				ok, err := upToDate(ctx, tx, s, fingerprint)
+				if err != nil {
+					return err
+				}
+				if ok {
+					log.Printf("stage %s: up to date", s.name)
+					return nil
+				}
+			}
+			log.Printf("stage %s: running", s.name)
+			if err := s.run(ctx, tx); err != nil {
+				return err
+			}
+			state := &models.PipelineStage{
+				PortfolioID: eupholio.PortfolioID(ctx),
+				Name:        s.name,
+				Fingerprint: fingerprint,
+				CompletedAt: time.Now(),
+			}
+			exists, err := models.PipelineStageExists(ctx, tx, state.PortfolioID, state.Name)
+			if err != nil {
+				return err
+			}
+			return save(ctx, tx, state, exists)
+		})
+		if err != nil {
+			return fmt.Errorf("stage %s: %w", s.name, err)
+		}
+	}
+	return nil
+}
+
+func upToDate(ctx context.Context, tx *sql.Tx, s *stage, fingerprint string) (bool, error) {
+	state, err := models.FindPipelineStage(ctx, tx, eupholio.PortfolioID(ctx), s.name)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if state.Fingerprint != fingerprint {
+		return false, nil
+	}
+	for _, output := range s.outputs {
+		if _, err := os.Stat(output); err != nil {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// stageFingerprint hashes the fingerprint of the previous stage and the inputs of a stage
+func stageFingerprint(previous string, s *stage) (string, error) {
+	h := sha256.New()
+	fmt.Fprintln(h, previous, s.name)
+	for _, input := range s.inputs {
+		if path, ok := input.(fileInput); ok {
+			if err := hashFile(h, string(path)); err != nil {
+				return "", err
+			}
+			continue
+		}
+		b, err := json.Marshal(input)
+		if err != nil {
+			return "", err
+		}
+		h.Write(b)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func hashFile(w io.Writer, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	fmt.Fprintln(w, path)
+	_, err = io.Copy(w, f)
+	return err
+}
+
+func pipelineStages(m *manifest.Manifest) ([]*stage, error) {
+	loc, err := time.LoadLocation(m.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	fiat := currency.Symbol(m.Fiat)
+
+	var stages []*stage
+
+	var downloads []*manifest.PriceSource
+	for _, p := range m.Prices {
+		if p.Download {
+			downloads = append(downloads, p)
+		}
+	}
+	if len(downloads) > 0 {
+		stages = append(stages, &stage{
+			name: "download",
+			// prices are downloaded at most once a day
+			inputs: []interface{}{downloads, time.Now().In(loc).Format("2006-01-02")},
+			run: func(ctx context.Context, tx *sql.Tx) error {
+				for _, p := range downloads {
+					if err := downloadPrices(m, p); err != nil {
+						return fmt.Errorf("%s: %w", p.Source, err)
+					}
+				}
+				return nil
+			},
+		})
+	}
+
+	if len(m.Prices) > 0 {
+		files := make([][]string, len(m.Prices))
+		inputs := []interface{}{m.Prices}
+		for i, p := range m.Prices {
+			if files[i], err = m.Glob(p.Files); err != nil {
+				return nil, err
+			}
+			for _, f := range files[i] {
+				inputs = append(inputs, fileInput(f))
+			}
+		}
+		stages = append(stages, &stage{
+			name:   "load",
+			inputs: inputs,
+			run: func(ctx context.Context, tx *sql.Tx) error {
+				for i, p := range m.Prices {
+					// files may have been downloaded by the previous stage
+					if p.Download {
+						var err error
+						if files[i], err = m.Glob(p.Files); err != nil {
+							return err
+						}
+					}
+					if err := loadPrices(tx, p.Source, files[i]); err != nil {
+						return fmt.Errorf("%s: %w", p.Source, err)
+					}
+				}
+				return nil
+			},
+		})
+	}
+
+	if len(m.Imports) > 0 {
+		files := make([][]string, len(m.Imports))
+		inputs := []interface{}{m.Imports}
+		for i, imp := range m.Imports {
+			if files[i], err = m.Glob(imp.Files...); err != nil {
+				return nil, err
+			}
+			if len(files[i]) == 0 {
+				return nil, fmt.Errorf("stage import: no files of %s found", imp.Exchange)
+			}
+			for _, f := range files[i] {
+				inputs = append(inputs, fileInput(f))
+			}
+		}
+		stages = append(stages, &stage{
+			name:   "import",
+			inputs: inputs,
+			run: func(ctx context.Context, tx *sql.Tx) error {
+				for i, imp := range m.Imports {
+					var err error
+					if imp.Exchange == manifest.ExchangeAuto {
+						err = ImportAutoData(ctx, tx, files[i], false, imp.Lenient, imp.Timezone, imp.Account)
+					} else {
+						err = ImportData(ctx, tx, imp.Exchange, files[i], false, imp.Lenient, imp.FileType, imp.Timezone, imp.Account)
+					}
+					if err != nil {
+						return fmt.Errorf("%s: %w", imp.Exchange, err)
+					}
+				}
+				return nil
+			},
+		})
+	}
+
+	var years []int
+	for _, y := range m.Years {
+		years = append(years, y.Year)
+	}
+	if len(years) == 0 {
+		years = []int{0} // all years
+	}
+
+	stages = append(stages, &stage{
+		name:   "costmethod",
+		inputs: []interface{}{m.Years},
+		run: func(ctx context.Context, tx *sql.Tx) error {
+			for _, y := range m.Years {
+				if y.Method == "" {
+					continue
+				}
+				if err := SetCostMethod(ctx, tx, y.Year, y.Method); err != nil {
+					return fmt.Errorf("%d: %w", y.Year, err)
+				}
+			}
+			return nil
+		},
+	})
+
+	stages = append(stages, &stage{
+		name:   "translate",
+		inputs: []interface{}{m.Fiat, m.Timezone, years},
+		run: func(ctx context.Context, tx *sql.Tx) error {
+			for _, y := range years {
+				if err := Translate(ctx, tx, y, loc, fiat); err != nil {
+					return fmt.Errorf("%d: %w", y, err)
+				}
+			}
+			return nil
+		},
+	})
+
+	stages = append(stages, &stage{
+		name:   "calculate",
+		inputs: []interface{}{m.VerifyWith, m.VerifyTolerance},
+		run: func(ctx context.Context, tx *sql.Tx) error {
+			// years changed by translation are recalculated with the later years depending on them
+			if err := Calculate(ctx, tx, 0, fiat, loc, ""); err != nil {
+				return err
+			}
+			if m.VerifyWith == "" {
+				return nil
+			}
+			for _, y := range m.Years {
+				if err := Verify(ctx, tx, y.Year, fiat, loc, "", m.VerifyWith, m.VerifyTolerance, false); err != nil {
+					return fmt.Errorf("%d: %w", y.Year, err)
+				}
+			}
+			return nil
+		},
+	})
+
+	if m.Reports != nil {
+		r := m.Reports
+		dir := m.Path(r.Dir)
+		var outputs []string
+		for _, y := range m.Years {
+			outputs = append(outputs, reportPaths(dir, y.Year, r.Format)...)
+		}
+		stages = append(stages, &stage{
+			name:    "report",
+			inputs:  []interface{}{r},
+			outputs: outputs,
+			run: func(ctx context.Context, tx *sql.Tx) error {
+				if err := os.MkdirAll(dir, 0755); err != nil {
+					return err
+				}
+				for _, y := range m.Years {
+					if err := writeReports(ctx, tx, dir, y.Year, loc, fiat, r); err != nil {
+						return fmt.Errorf("%d: %w", y.Year, err)
+					}
+				}
+				return nil
+			},
+		})
+	}
+	return stages, nil
+}
+
+func downloadPrices(m *manifest.Manifest, p *manifest.PriceSource) error {
+	dir := m.Path(p.Dir)
+	switch p.Source {
+	case manifest.SourceCoingecko:
+		return DownloadCoingeckoHistoricalPrice(dir)
+	case manifest.SourceCryptoDataDownload:
+		return DownloadCryptoDataDownloadHistoricalPrice(dir)
+	case manifest.SourceYahooFinance:
+		fiat, symbols := p.Fiat, p.Symbols
+		if len(fiat) == 0 {
+			fiat = currency.FiatCurrencies.Strings()
+		}
+		if len(symbols) == 0 {
+			symbols = currency.BaseCurrencies.Strings()
+		}
+		return DownloadYahooFinanceHistoricalPrice(dir, fiat, symbols)
+	}
+	return fmt.Errorf("unknown price source %s", p.Source)
+}
+
+func loadPrices(db boil.ContextExecutor, source string, files []string) error {
+	switch source {
+	case manifest.SourceCoingecko:
+		return LoadCoingeckoHistoricalPrice(db, files)
+	case manifest.SourceCryptoDataDownload:
+		return LoadCDDHistoricalPrice(db, files)
+	case manifest.SourceYahooFinance:
+		return LoadYahooFinanceHistoricalPrice(db, files)
+	}
+	return fmt.Errorf("unknown price source %s", source)
+}
+
+func reportPaths(dir string, year int, format string) []string {
+	ext := "txt"
+	if querycmd.OutputFormat(format) == querycmd.OutputFormatCSV {
+		ext = "csv"
+	}
+	return []string{
+		filepath.Join(dir, fmt.Sprintf("balance-%d.%s", year, ext)),
+		filepath.Join(dir, fmt.Sprintf("transaction-%d.%s", year, ext)),
+	}
+}
+
+// writeReports writes the balance and transactions of a year
+func writeReports(ctx context.Context, tx *sql.Tx, dir string, year int, loc *time.Location, fiat currency.Symbol, r *manifest.Reports) error {
+	paths := reportPaths(dir, year, r.Format)
+	of := querycmd.OutputFormat(r.Format)
+	err := writeReport(paths[0], func(w io.Writer) error {
+		return querycmd.QueryBalance(ctx, w, tx, year, loc, fiat, r.Source, "", false, of)
+	})
+	if err != nil {
+		return err
+	}
+	return writeReport(paths[1], func(w io.Writer) error {
+		return querycmd.QueryTransactions(ctx, w, tx, year, loc, string(fiat), r.Source, "", of)
+	})
+}
+
+func writeReport(path string, write func(w io.Writer) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
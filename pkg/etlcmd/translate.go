@@ -23,55 +23,221 @@ package etlcmd
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"log"
 	"time"
 
+	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 
 	"github.com/eupholio/eupholio/models"
-	"github.com/eupholio/eupholio/pkg/bitflyer"
-	"github.com/eupholio/eupholio/pkg/bittrex"
-	"github.com/eupholio/eupholio/pkg/coincheck"
-	"github.com/eupholio/eupholio/pkg/cryptact"
 	"github.com/eupholio/eupholio/pkg/currency"
 	"github.com/eupholio/eupholio/pkg/eupholio"
-	"github.com/eupholio/eupholio/pkg/poloniex"
 	"github.com/eupholio/eupholio/pkg/repository"
 )
 
+// Translate translates raw rows of a year to transactions and events.
+// If year is 0, raw rows imported since the last translation are translated.
 func Translate(ctx context.Context, tx *sql.Tx, year int, jst *time.Location, fiat currency.Symbol) error {
-	translators := map[string]eupholio.Translator{
-		"bitflyer":  bitflyer.NewTranslator(),
-		"coincheck": coincheck.NewTranslator(),
-		"bittrex":   bittrex.NewTranslator(currency.JPY),
-		"poloniex":  poloniex.NewTranslator(currency.JPY),
-		"cryptact":  cryptact.NewTranslator(currency.JPY),
+	repo := repository.New(tx, fiat)
+	if year == 0 {
+		return translateNewRows(ctx, tx, repo, jst, fiat)
 	}
+
 	start := time.Date(year, time.January, 1, 0, 0, 0, 0, jst)
 	end := time.Date(year+1, time.January, 1, 0, 0, 0, 0, jst)
+	for _, e := range eupholio.Exchanges() {
+		if e.Translator == nil {
+			continue
+		}
+		log.Println("translate", e.Name)
+		err := e.Translator(tx, fiat).Translate(ctx, repo, start, end)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	if year == 0 {
-		latest, err := models.Events(
-			qm.OrderBy("time DESC"),
-			qm.Limit(1),
-		).One(ctx, tx)
-		if err == nil {
-			start = latest.Time
-		} else {
-			start = time.Date(2015, time.January, 1, 0, 0, 0, 0, jst)
+// translateNewRows translates raw rows above the watermarks of exchanges regardless of their times.
+// Transactions of an exchange are translated again for the years of the new rows, so each row is
+// translated exactly once however late it is imported. If rows below a watermark have been deleted
+// or replaced, all the years of the rows and the transactions of the exchange are translated again.
+func translateNewRows(ctx context.Context, tx *sql.Tx, repo eupholio.Repository, loc *time.Location, fiat currency.Symbol) error {
+	for _, e := range eupholio.Exchanges() {
+		if e.Translator == nil {
+			continue
+		}
+		var marks models.TranslationWatermarkSlice
+		var first, last time.Time
+		span := func(s, l time.Time) {
+			if s.IsZero() {
+				return
+			}
+			if first.IsZero() || s.Before(first) {
+				first = s
+			}
+			if l.After(last) {
+				last = l
+			}
+		}
+		changed := false
+		for _, table := range e.RawTables {
+			rows, err := findNewRows(ctx, tx, e.Name, table)
+			if err != nil {
+				return err
+			}
+			if rows == nil {
+				continue
+			}
+			marks = append(marks, rows.mark)
+			span(rows.first, rows.last)
+			changed = changed || rows.changed
+		}
+		if len(marks) == 0 {
+			log.Println("translate", e.Name, "up to date")
+			continue
+		}
+		if changed {
+			log.Println("rows of", e.Name, "have been deleted or replaced since the last translation")
+			for _, table := range e.RawTables {
+				s, l, err := findRowTimes(ctx, tx, table, "1 = 1")
+				if err != nil {
+					return err
+				}
+				span(s, l)
+			}
+			s, l, err := findTransactionTimes(ctx, tx, e)
+			if err != nil {
+				return err
+			}
+			span(s, l)
+		}
+
+		if !first.IsZero() {
+			start := time.Date(first.In(loc).Year(), time.January, 1, 0, 0, 0, 0, loc)
+			end := time.Date(last.In(loc).Year()+1, time.January, 1, 0, 0, 0, 0, loc)
+			log.Printf("translate %s from %d to %d", e.Name, start.Year(), end.Year()-1)
+			if err := e.Translator(tx, fiat).Translate(ctx, repo, start, end); err != nil {
+				return err
+			}
+		}
+		for _, mark := range marks {
+			mark.TranslatedAt = time.Now()
+			exists, err := models.TranslationWatermarkExists(ctx, tx, mark.PortfolioID, mark.Exchange, mark.RawTable)
+			if err != nil {
+				return err
+			}
+			if err := save(ctx, tx, mark, exists); err != nil {
+				return err
+			}
 		}
-		end = time.Now()
 	}
+	return nil
+}
 
-	repo := repository.New(tx, fiat)
+// newRows are rows of a raw table which have not been translated
+type newRows struct {
+	mark        *models.TranslationWatermark // watermark moved to the last row
+	first, last time.Time                    // times of the rows above the current watermark
+	changed     bool                         // whether rows below the current watermark have been deleted or replaced
+}
 
-	start = start.Add(time.Second) // XXX
-	for name, trans := range translators {
-		log.Println("translate", name)
-		err := trans.Translate(ctx, repo, start, end)
+// findNewRows returns rows above the watermark of a raw table, or nil if there are neither new rows nor changes.
+// Rows below the watermark are compared by their number and the sum of their ids.
+func findNewRows(ctx context.Context, db boil.ContextExecutor, exchange string, table eupholio.RawTable) (*newRows, error) {
+	portfolioID := eupholio.PortfolioID(ctx)
+	mark, err := models.FindTranslationWatermark(ctx, db, portfolioID, exchange, table.Name)
+	if err == sql.ErrNoRows {
+		mark = &models.TranslationWatermark{
+			PortfolioID: portfolioID,
+			Exchange:    exchange,
+			RawTable:    table.Name,
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	var count int
+	var checksum int64
+	q := fmt.Sprintf("SELECT COUNT(*), COALESCE(SUM(id), 0) FROM `%s` WHERE portfolio_id = ? AND id <= ?", table.Name)
+	if err := db.QueryRowContext(ctx, q, portfolioID, mark.RawID).Scan(&count, &checksum); err != nil {
+		return nil, err
+	}
+	rows := &newRows{
+		mark:    mark,
+		changed: count != mark.RowCount || checksum != mark.RowChecksum,
+	}
+
+	var lastID sql.NullInt64
+	var newCount int
+	var newChecksum int64
+	q = fmt.Sprintf("SELECT MAX(id), COUNT(*), COALESCE(SUM(id), 0) FROM `%s` WHERE portfolio_id = ? AND id > ?", table.Name)
+	if err := db.QueryRowContext(ctx, q, portfolioID, mark.RawID).Scan(&lastID, &newCount, &newChecksum); err != nil {
+		return nil, err
+	}
+	if !lastID.Valid && !rows.changed {
+		return nil, nil
+	}
+
+	if lastID.Valid {
+		rows.first, rows.last, err = findRowTimes(ctx, db, table, "id > ?", mark.RawID)
 		if err != nil {
-			return err
+			return nil, err
 		}
+		mark.RawID = int(lastID.Int64)
 	}
-	return nil
+	mark.RowCount = count + newCount
+	mark.RowChecksum = checksum + newChecksum
+	return rows, nil
+}
+
+// findTransactionTimes returns the first and the last times of transactions of the wallets of an exchange,
+// or zero times if there are no transactions
+func findTransactionTimes(ctx context.Context, db boil.ContextExecutor, e *eupholio.Exchange) (time.Time, time.Time, error) {
+	var codes []interface{}
+	for code := range e.WalletCodes {
+		codes = append(codes, code)
+	}
+	if len(codes) == 0 {
+		return time.Time{}, time.Time{}, nil
+	}
+	var first, last time.Time
+	for _, t := range []struct {
+		order string
+		dest  *time.Time
+	}{{"ASC", &first}, {"DESC", &last}} {
+		transaction, err := models.Transactions(
+			eupholio.InPortfolio(ctx),
+			qm.WhereIn("wallet_code IN ?", codes...),
+			qm.OrderBy("time "+t.order),
+		).One(ctx, db)
+		if err == sql.ErrNoRows {
+			return time.Time{}, time.Time{}, nil
+		} else if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		*t.dest = transaction.Time
+	}
+	return first, last, nil
+}
+
+// findRowTimes returns the first and the last times of rows of a raw table of the portfolio of ctx,
+// which match a condition, or zero times if there are no such rows
+func findRowTimes(ctx context.Context, db boil.ContextExecutor, table eupholio.RawTable, cond string, args ...interface{}) (time.Time, time.Time, error) {
+	// times are not aggregated by MIN and MAX because SQLite returns them as text
+	var first, last time.Time
+	for _, t := range []struct {
+		order string
+		dest  *time.Time
+	}{{"ASC", &first}, {"DESC", &last}} {
+		q := fmt.Sprintf("SELECT `%[2]s` FROM `%[1]s` WHERE portfolio_id = ? AND %[4]s ORDER BY `%[2]s` %[3]s LIMIT 1", table.Name, table.TimeColumn, t.order, cond)
+		err := db.QueryRowContext(ctx, q, append([]interface{}{eupholio.PortfolioID(ctx)}, args...)...).Scan(t.dest)
+		if err == sql.ErrNoRows {
+			return time.Time{}, time.Time{}, nil
+		} else if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+	return first, last, nil
 }
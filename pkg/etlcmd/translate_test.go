@@ -0,0 +1,45 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package etlcmd
+
+import (
+	"testing"
+
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+func TestRawTables(t *testing.T) {
+	seen := make(map[string]string)
+	for _, e := range eupholio.Exchanges() {
+		if e.Translator != nil && len(e.RawTables) == 0 {
+			t.Errorf("%s has no raw tables to find new rows", e.Name)
+		}
+		for _, table := range e.RawTables {
+			if table.TimeColumn == "" {
+				t.Errorf("%s: time column of %s is empty", e.Name, table.Name)
+			}
+			if other, ok := seen[table.Name]; ok {
+				t.Errorf("%s is registered by %s and %s", table.Name, other, e.Name)
+			}
+			seen[table.Name] = e.Name
+		}
+	}
+}
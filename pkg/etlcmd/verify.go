@@ -0,0 +1,93 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package etlcmd
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+
+	"github.com/eupholio/eupholio/pkg/core"
+	"github.com/eupholio/eupholio/pkg/currency"
+	"github.com/eupholio/eupholio/pkg/repository"
+)
+
+// Verify compares calculated balances with the result of eupholio-core-cli, and fails if they disagree beyond the tolerance
+// unless warnOnly is set. Balances must have been calculated by Calculate with the same arguments.
+func Verify(ctx context.Context, tx *sql.Tx, year int, fiatCurrency currency.Symbol, loc *time.Location, method string, cli string, tolerance string, warnOnly bool) error {
+	tol, ok := new(decimal.Big).SetString(tolerance)
+	if !ok {
+		return fmt.Errorf("invalid tolerance %s", tolerance)
+	}
+
+	var years []int
+	if year == 0 {
+		now := time.Now()
+		for i := 2008; i <= now.Year(); i++ {
+			years = append(years, i)
+		}
+	} else {
+		years = append(years, year)
+	}
+
+	repo := repository.New(tx, fiatCurrency)
+
+	failed := 0
+	for _, y := range years {
+		// income is split as eupholio calculates it as a disposal at the market price
+		input, err := BuildCoreInput(ctx, tx, y, loc, fiatCurrency, method, true)
+		if err != nil {
+			return err
+		}
+		if len(input.Events) == 0 && len(input.CarryIn) == 0 {
+			continue
+		}
+		report, err := core.Run(ctx, cli, input)
+		if err != nil {
+			return err
+		}
+		balances, err := repo.FindBalancesByYear(ctx, y)
+		if err != nil {
+			return err
+		}
+		diffs, err := core.Compare(balances, report, fiatCurrency.String(), tol)
+		if err != nil {
+			return err
+		}
+		if len(diffs) == 0 {
+			log.Printf("verify %d: ok", y)
+			continue
+		}
+		failed++
+		log.Printf("verify %d: %d differences", y, len(diffs))
+		for _, d := range diffs {
+			log.Printf("  %s", d)
+		}
+	}
+	if failed > 0 && !warnOnly {
+		return fmt.Errorf("result of %d years disagrees with %s", failed, cli)
+	}
+	return nil
+}
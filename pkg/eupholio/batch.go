@@ -0,0 +1,193 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package eupholio
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+// Row is a raw row of a file, which is a model generated by sqlboiler with PortfolioID, Account, BatchID and RowKey
+type Row interface {
+	Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error
+}
+
+// Batch is an import of a file. Rows inserted in a batch are marked with its id, and rows which have
+// the same natural key as stored ones are skipped, so that overlapping files can be imported.
+type Batch struct {
+	ID       int
+	Inserted int
+	Skipped  int
+	Rejected RowErrors
+	counts   map[string]int
+}
+
+// NewBatch creates a batch. Rows are not marked if id is 0.
+func NewBatch(id int) *Batch {
+	return &Batch{ID: id}
+}
+
+// BatchOption sets the batch of an import
+func BatchOption(batch *Batch) Option {
+	return func(config *Config) {
+		config.Batch = batch
+	}
+}
+
+// InsertRow inserts a raw row to a table unless a row which has the same natural key is stored
+func (c *Config) InsertRow(ctx context.Context, db boil.ContextExecutor, table string, row Row) error {
+	if c.Batch == nil {
+		c.Batch = NewBatch(0)
+	}
+	if c.Account != "" {
+		account := reflect.ValueOf(row).Elem().FieldByName("Account")
+		if !account.IsValid() {
+			return fmt.Errorf("row %T has no account column", row)
+		}
+		account.SetString(c.Account)
+	}
+	return c.Batch.InsertRow(ctx, db, table, row)
+}
+
+// InsertRow inserts a raw row to a table of the portfolio of ctx unless a row which has the same natural key is stored
+func (b *Batch) InsertRow(ctx context.Context, db boil.ContextExecutor, table string, row Row) error {
+	rawTable, _ := LookupRawTable(table)
+	key, contentKey, err := b.rowKey(row, rawTable.KeyColumns)
+	if err != nil {
+		return err
+	}
+	portfolioID := PortfolioID(ctx)
+	var n int
+	err = db.QueryRowContext(ctx, fmt.Sprintf("SELECT COUNT(*) FROM `%s` WHERE `portfolio_id` = ? AND `row_key` IN (?, ?)", table), portfolioID, key, contentKey).Scan(&n)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		b.Skipped++
+		return nil
+	}
+
+	v := reflect.ValueOf(row).Elem()
+	v.FieldByName("PortfolioID").SetInt(int64(portfolioID))
+	v.FieldByName("RowKey").Set(reflect.ValueOf(null.StringFrom(key)))
+	if b.ID != 0 {
+		v.FieldByName("BatchID").Set(reflect.ValueOf(null.IntFrom(b.ID)))
+	}
+	if err := row.Insert(ctx, db, boil.Infer()); err != nil {
+		return err
+	}
+	b.Inserted++
+	return nil
+}
+
+// rowKey returns the natural key of a row, which is the hash of the values of keyColumns if none of them are
+// empty, or of all the columns otherwise, and the number of the same rows found before in the batch. The key
+// hashed from all the columns is also returned, which is the key of the row imported before keyColumns are given.
+// The portfolio and the default account are not hashed, so that keys of rows imported before them are kept.
+func (b *Batch) rowKey(row Row, keyColumns []string) (string, string, error) {
+	v := reflect.ValueOf(row)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return "", "", fmt.Errorf("unsupported row %T", row)
+	}
+	v = v.Elem()
+	if !v.FieldByName("RowKey").IsValid() || !v.FieldByName("BatchID").IsValid() || !v.FieldByName("PortfolioID").IsValid() {
+		return "", "", fmt.Errorf("row %T has no batch columns", row)
+	}
+
+	isKey := make(map[string]bool)
+	for _, column := range keyColumns {
+		isKey[column] = true
+	}
+	var values, keyValues []string
+	for i := 0; i < v.NumField(); i++ {
+		column := v.Type().Field(i).Tag.Get("boil")
+		switch column {
+		case "", "-", "id", "portfolio_id", "batch_id", "row_key":
+			continue
+		case "account":
+			if v.Field(i).String() == "" {
+				continue
+			}
+			keyValues = append(keyValues, column+"="+v.Field(i).String())
+		}
+		value := column + "=" + formatValue(v.Field(i).Interface())
+		values = append(values, value)
+		if isKey[column] && !v.Field(i).IsZero() {
+			keyValues = append(keyValues, value)
+			delete(isKey, column)
+		}
+	}
+
+	if b.counts == nil {
+		b.counts = make(map[string]int)
+	}
+	count := func(content string) string {
+		n := b.counts[content]
+		b.counts[content]++
+		return hash(fmt.Sprintf("%s:%d", content, n))
+	}
+	contentKey := count(hash(strings.Join(values, "\n")))
+	if len(keyColumns) == 0 || len(isKey) > 0 {
+		return contentKey, contentKey, nil
+	}
+	return count("key:" + hash(strings.Join(keyValues, "\n"))), contentKey, nil
+}
+
+func formatValue(x interface{}) string {
+	switch v := x.(type) {
+	case time.Time:
+		return v.UTC().Format(time.RFC3339Nano)
+	case types.Decimal:
+		return formatDecimal(v.Big)
+	case types.NullDecimal:
+		return formatDecimal(v.Big)
+	case driver.Valuer:
+		value, err := v.Value()
+		if err != nil || value == nil {
+			return "NULL"
+		}
+		return formatValue(value)
+	}
+	return fmt.Sprint(x)
+}
+
+func formatDecimal(x *decimal.Big) string {
+	if x == nil {
+		return "NULL"
+	}
+	return fmt.Sprintf("%f", new(decimal.Big).Copy(x).Reduce())
+}
+
+func hash(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
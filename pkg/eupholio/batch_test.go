@@ -0,0 +1,117 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package eupholio
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/eupholio/eupholio/models"
+)
+
+func TestRowKey(t *testing.T) {
+	newRow := func(id int, quantity string) *models.LedgerEntry {
+		q, _ := new(decimal.Big).SetString(quantity)
+		return &models.LedgerEntry{
+			ID:          id,
+			Version:     1,
+			Tid:         "otc-1",
+			Time:        time.Date(2020, time.January, 2, 1, 0, 0, 0, time.UTC),
+			Wallet:      "OTC",
+			Type:        "buy",
+			Currency:    "BTC",
+			Quantity:    types.NewDecimal(q),
+			FeeQuantity: types.NewDecimal(new(decimal.Big)),
+		}
+	}
+
+	b1 := NewBatch(1)
+	k1, _, _ := b1.rowKey(newRow(0, "0.10"), nil)
+	k2, _, _ := b1.rowKey(newRow(0, "0.1"), nil)
+	if k1 == k2 {
+		t.Error("the same rows in a batch must have different keys")
+	}
+
+	b2 := NewBatch(2)
+	k3, _, _ := b2.rowKey(newRow(10, "0.1"), nil)
+	if k1 != k3 {
+		t.Error("the same rows in different batches must have the same key")
+	}
+	k4, _, _ := b2.rowKey(newRow(10, "0.2"), nil)
+	if k4 == k2 {
+		t.Error("different rows must have different keys")
+	}
+
+	corp := newRow(10, "0.1")
+	corp.Account = "corp"
+	k5, _, _ := NewBatch(3).rowKey(corp, nil)
+	if k5 == k3 {
+		t.Error("the same rows of different accounts must have different keys")
+	}
+
+	other := newRow(10, "0.1")
+	other.PortfolioID = 1
+	k6, _, _ := NewBatch(4).rowKey(other, nil)
+	if k6 != k3 {
+		t.Error("portfolios must not change keys")
+	}
+
+	if _, _, err := b2.rowKey(&models.Event{}, nil); err == nil {
+		t.Error("rows without batch columns must be rejected")
+	}
+}
+
+func TestRowKeyOfKeyColumns(t *testing.T) {
+	newRow := func(idCode, comment string) *models.CoincheckHistory {
+		return &models.CoincheckHistory{
+			IDCode:          idCode,
+			Time:            time.Date(2020, time.January, 2, 1, 0, 0, 0, time.UTC),
+			Operation:       "Received",
+			Amount:          types.NewDecimal(decimal.New(1, 1)),
+			TradingCurrency: "BTC",
+			Comment:         comment,
+		}
+	}
+	keyColumns := []string{"id_code"}
+
+	k1, c1, _ := NewBatch(1).rowKey(newRow("100", "before"), keyColumns)
+	k2, c2, _ := NewBatch(2).rowKey(newRow("100", "corrected"), keyColumns)
+	if k1 != k2 {
+		t.Error("rows of the same id must have the same key")
+	}
+	if c1 == c2 {
+		t.Error("keys of the contents must differ")
+	}
+	if c3, _, _ := NewBatch(3).rowKey(newRow("100", "before"), nil); c3 != c1 {
+		t.Error("the key of the contents must be the key without key columns")
+	}
+	k4, _, _ := NewBatch(4).rowKey(newRow("101", "before"), keyColumns)
+	if k4 == k1 {
+		t.Error("rows of different ids must have different keys")
+	}
+	k5, c5, _ := NewBatch(5).rowKey(newRow("", "before"), keyColumns)
+	if k5 != c5 {
+		t.Error("rows without ids must be keyed by their contents")
+	}
+}
@@ -20,11 +20,16 @@
 
 package eupholio
 
-import "github.com/eupholio/eupholio/models"
+import (
+	"context"
 
-func NewDefaultConfig(year int) *models.Config {
+	"github.com/eupholio/eupholio/models"
+)
+
+// NewDefaultConfig returns the config of a year which is not configured, whose cost method is the default of ctx
+func NewDefaultConfig(ctx context.Context, year int) *models.Config {
 	return &models.Config{
 		Year:       year,
-		CostMethod: "wam",
+		CostMethod: DefaultsOf(ctx).CostMethod,
 	}
 }
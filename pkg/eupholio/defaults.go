@@ -0,0 +1,66 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package eupholio
+
+import "context"
+
+// Defaults are settings used where they are not configured in the database.
+// Fiat and Timezone are of portfolios created without them, and CostMethod is of years without configs.
+type Defaults struct {
+	Fiat         string
+	Timezone     string
+	CostMethod   string
+	PriceSources []string // sources of market prices in the order of priority. Other sources follow them
+}
+
+type defaultsKey struct{}
+
+// NewDefaults returns the built-in defaults
+func NewDefaults() *Defaults {
+	return &Defaults{
+		Fiat:       "JPY",
+		Timezone:   "Asia/Tokyo",
+		CostMethod: "wam",
+	}
+}
+
+// WithDefaults returns a context which carries defaults
+func WithDefaults(ctx context.Context, defaults *Defaults) context.Context {
+	return context.WithValue(ctx, defaultsKey{}, defaults)
+}
+
+// DefaultsOf returns the defaults of a context, which are the built-in defaults if not given
+func DefaultsOf(ctx context.Context) *Defaults {
+	if defaults, ok := ctx.Value(defaultsKey{}).(*Defaults); ok && defaults != nil {
+		return defaults
+	}
+	return NewDefaults()
+}
+
+// PriceSourcePriority returns the priority of a source of market prices, which is smaller for a preferred source
+func (d *Defaults) PriceSourcePriority(source string) int {
+	for i, s := range d.PriceSources {
+		if s == source {
+			return i
+		}
+	}
+	return len(d.PriceSources)
+}
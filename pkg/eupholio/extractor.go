@@ -30,6 +30,9 @@ import (
 type Config struct {
 	Overwrite bool
 	Debug     bool
+	Lenient   bool
+	Account   string
+	Batch     *Batch
 }
 
 type Option func(config *Config)
@@ -40,12 +43,42 @@ func OverwriteOption() Option {
 	}
 }
 
+// LenientOption imports rows which can be imported and quarantines the others in the batch,
+// instead of failing with all the rows which cannot be imported
+func LenientOption() Option {
+	return func(config *Config) {
+		config.Lenient = true
+	}
+}
+
+// AccountOption marks imported rows with an account, which tells accounts of the same exchange apart
+func AccountOption(account string) Option {
+	return func(config *Config) {
+		config.Account = account
+	}
+}
+
 func DebugOption() Option {
 	return func(config *Config) {
 		config.Debug = true
 	}
 }
 
+// HandleRowErrors returns rows which cannot be imported as an error, or quarantines them in the batch in lenient mode
+func (c *Config) HandleRowErrors(errs RowErrors) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	if !c.Lenient {
+		return errs
+	}
+	if c.Batch == nil {
+		c.Batch = NewBatch(0)
+	}
+	c.Batch.Rejected = append(c.Batch.Rejected, errs...)
+	return nil
+}
+
 type Extractor interface {
 	Execute(ctx context.Context, db boil.ContextExecutor, reader io.Reader, options ...Option) error
 }
@@ -0,0 +1,275 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package eupholio
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ericlagergren/decimal"
+
+	"github.com/eupholio/eupholio/models"
+)
+
+// MovementType is a kind of movement of assets
+type MovementType string
+
+// Movement types
+const (
+	MovementTrade    MovementType = "trade"
+	MovementIncome   MovementType = "income"
+	MovementFee      MovementType = "fee"
+	MovementDeposit  MovementType = "deposit"
+	MovementWithdraw MovementType = "withdraw"
+)
+
+// Income and fee labels used in descriptions of translated transactions
+const (
+	LabelIncome  = "income"
+	LabelMining  = "mining"
+	LabelStaking = "staking"
+	LabelLending = "lending"
+	LabelAirdrop = "airdrop"
+	LabelCost    = "cost"
+)
+
+// Amount is a quantity of a currency
+type Amount struct {
+	Currency string
+	Quantity *decimal.Big
+}
+
+// IsZero reports whether no quantity is specified
+func (a Amount) IsZero() bool {
+	return a.Currency == "" || a.Quantity == nil || a.Quantity.Sign() == 0
+}
+
+// Movement is a row-level view of a transaction, which is the common ground of
+// the universal formats of other portfolio trackers (Koinly, CoinTracking).
+// Sent and Received are the quantities moved besides the fee, and Value is
+// the value of an income or a fee (the market price is used if it is zero).
+type Movement struct {
+	Type     MovementType
+	Label    string
+	Sent     Amount
+	Received Amount
+	Fee      Amount
+	Value    Amount
+}
+
+// Description returns a description of the translated transaction
+func (m *Movement) Description() string {
+	switch m.Type {
+	case MovementTrade:
+		return fmt.Sprintf("trade %s/%s", m.Received.Currency, m.Sent.Currency)
+	case MovementIncome:
+		return fmt.Sprintf("%s %s", labelOr(m.Label, LabelIncome), m.Received.Currency)
+	case MovementFee:
+		return fmt.Sprintf("%s %s", labelOr(m.Label, LabelCost), m.Sent.Currency)
+	case MovementDeposit:
+		return fmt.Sprintf("deposit %s", m.Received.Currency)
+	case MovementWithdraw:
+		return fmt.Sprintf("withdraw %s", m.Sent.Currency)
+	}
+	return ""
+}
+
+// Events translates a movement to events. Trades are paid in the sent currency unless the fiat is received.
+func (m *Movement) Events(fiat string, newEvent func(typ, currency string, quantity *decimal.Big, baseCurrency string, baseQuantity *decimal.Big) *models.Event) ([]*models.Event, error) {
+	zero := decimal.New(0, 0)
+	value := m.Value
+	var events []*models.Event
+	switch m.Type {
+	case MovementTrade:
+		if m.Sent.IsZero() || m.Received.IsZero() {
+			return nil, fmt.Errorf("trade requires both sent and received amounts")
+		}
+		s, r := m.Sent, m.Received
+		if r.Currency == fiat && s.Currency != fiat {
+			sell := newEvent(EventTypeSell, s.Currency, s.Quantity, r.Currency, r.Quantity)
+			buy := newEvent(EventTypeBuy, r.Currency, r.Quantity, r.Currency, r.Quantity)
+			events = append(events, sell, buy)
+		} else {
+			sell := newEvent(EventTypeSell, s.Currency, s.Quantity, s.Currency, s.Quantity)
+			buy := newEvent(EventTypeBuy, r.Currency, r.Quantity, s.Currency, s.Quantity)
+			events = append(events, sell, buy)
+		}
+	case MovementIncome:
+		r := m.Received
+		if r.IsZero() {
+			return nil, fmt.Errorf("income requires a received amount")
+		}
+		if value.IsZero() {
+			value = r
+		}
+		buy := newEvent(EventTypeBuy, r.Currency, r.Quantity, value.Currency, zero)             // acquisition without cost
+		sell := newEvent(EventTypeSell, r.Currency, r.Quantity, value.Currency, value.Quantity) // earning
+		buy2 := newEvent(EventTypeBuy, r.Currency, r.Quantity, value.Currency, value.Quantity)  // income
+		events = append(events, buy, sell, buy2)
+	case MovementFee:
+		s := m.Sent
+		if s.IsZero() {
+			return nil, fmt.Errorf("fee requires a sent amount")
+		}
+		if value.IsZero() {
+			value = s
+		}
+		events = append(events, newEvent(EventTypeFee, s.Currency, s.Quantity, value.Currency, value.Quantity))
+	case MovementDeposit:
+		r := m.Received
+		if r.IsZero() {
+			return nil, fmt.Errorf("deposit requires a received amount")
+		}
+		events = append(events, newEvent(EventTypeDeposit, r.Currency, r.Quantity, r.Currency, zero))
+	case MovementWithdraw:
+		s := m.Sent
+		if s.IsZero() {
+			return nil, fmt.Errorf("withdrawal requires a sent amount")
+		}
+		events = append(events, newEvent(EventTypeWithdraw, s.Currency, s.Quantity, s.Currency, zero))
+	default:
+		return nil, fmt.Errorf("unknown movement type %s", m.Type)
+	}
+	if !m.Fee.IsZero() {
+		f := m.Fee
+		events = append(events, newEvent(EventTypeFee, f.Currency, f.Quantity, f.Currency, f.Quantity))
+	}
+	return events, nil
+}
+
+// Movements decomposes events of a transaction into movements, which are translated back to equivalent events.
+func Movements(tr *EventsOfTransaction) ([]*Movement, error) {
+	label := ""
+	if fields := strings.Fields(tr.Description); len(fields) > 0 {
+		label = strings.ToLower(fields[0])
+	}
+
+	var ms []*Movement
+	var buys, sells, commissions []*models.Event
+	var tradeFee *models.Event // fee paid besides a trade, which is translated from a movement
+	events := tr.Events
+	for i := 0; i < len(events); i++ {
+		ev := events[i]
+		// income: acquisition, earning and re-acquisition of the same quantity
+		if ev.Type == EventTypeBuy {
+			j := i + 1
+			var miningCost *models.Event // mining cost is paid right after the acquisition
+			if j < len(events) && events[j].Type == EventTypeFee && events[j].Currency == ev.BaseCurrency {
+				miningCost = events[j]
+				j++
+			}
+			if j+1 < len(events) && isIncome(ev, events[j], events[j+1]) && (miningCost != nil || ev.BaseQuantity.Big.Sign() == 0) {
+				earning := events[j]
+				l := label
+				if miningCost != nil {
+					l = LabelMining
+					ms = append(ms, &Movement{Type: MovementFee, Label: LabelCost, Sent: amountOf(miningCost), Value: baseAmountOf(miningCost)})
+				}
+				ms = append(ms, &Movement{Type: MovementIncome, Label: l, Received: amountOf(ev), Value: baseAmountOf(earning)})
+				i = j + 1
+				continue
+			}
+		}
+		switch ev.Type {
+		case EventTypeBuy:
+			buys = append(buys, ev)
+		case EventTypeSell:
+			sells = append(sells, ev)
+		case EventTypeCommission:
+			commissions = append(commissions, ev)
+		case EventTypeFee:
+			if i >= 2 && events[i-1].Type == EventTypeBuy && events[i-2].Type == EventTypeSell && baseAmountOf(ev).IsZero() && tradeFee == nil {
+				tradeFee = ev
+				continue
+			}
+			ms = append(ms, &Movement{Type: MovementFee, Label: label, Sent: amountOf(ev), Value: baseAmountOf(ev)})
+		case EventTypeDeposit:
+			ms = append(ms, &Movement{Type: MovementDeposit, Received: amountOf(ev)})
+		case EventTypeWithdraw:
+			ms = append(ms, &Movement{Type: MovementWithdraw, Sent: amountOf(ev)})
+		default:
+			return nil, fmt.Errorf("unknown event type %s", ev.Type)
+		}
+	}
+
+	switch {
+	case len(buys) == 1 && len(sells) == 1 && len(commissions) <= 1:
+		// the fee is moved besides the sent and received quantities
+		m := &Movement{Type: MovementTrade, Sent: amountOf(sells[0]), Received: amountOf(buys[0])}
+		if len(commissions) == 1 {
+			c := commissions[0]
+			fee := new(decimal.Big).Abs(c.Quantity.Big)
+			switch c.Currency {
+			case m.Sent.Currency:
+				m.Sent.Quantity = new(decimal.Big).Sub(m.Sent.Quantity, fee)
+			case m.Received.Currency:
+				m.Received.Quantity = new(decimal.Big).Add(m.Received.Quantity, fee)
+			default:
+				return nil, fmt.Errorf("commission currency %s is neither %s nor %s", c.Currency, m.Sent.Currency, m.Received.Currency)
+			}
+			m.Fee = Amount{Currency: c.Currency, Quantity: fee}
+		}
+		if tradeFee != nil {
+			if len(commissions) == 1 {
+				ms = append(ms, &Movement{Type: MovementFee, Label: LabelCost, Sent: amountOf(tradeFee)})
+			} else {
+				m.Fee = amountOf(tradeFee)
+			}
+			tradeFee = nil
+		}
+		ms = append(ms, m)
+	case len(buys) == 1 && len(sells) == 0 && len(commissions) == 0: // acquisition without payment
+		ms = append(ms, &Movement{Type: MovementIncome, Label: label, Received: amountOf(buys[0]), Value: baseAmountOf(buys[0])})
+	case len(buys) == 0 && len(sells) == 0 && len(commissions) == 0:
+	default:
+		return nil, fmt.Errorf("%d buy, %d sell and %d commission events cannot be decomposed", len(buys), len(sells), len(commissions))
+	}
+	if tradeFee != nil {
+		ms = append(ms, &Movement{Type: MovementFee, Label: label, Sent: amountOf(tradeFee)})
+	}
+	return ms, nil
+}
+
+func isIncome(buy, earning, rebuy *models.Event) bool {
+	return earning.Type == EventTypeSell && rebuy.Type == EventTypeBuy &&
+		buy.Currency == earning.Currency && buy.Currency == rebuy.Currency &&
+		buy.Quantity.Big.Cmp(earning.Quantity.Big) == 0 && buy.Quantity.Big.Cmp(rebuy.Quantity.Big) == 0 &&
+		earning.BaseCurrency == rebuy.BaseCurrency && earning.BaseQuantity.Big.Cmp(rebuy.BaseQuantity.Big) == 0
+}
+
+func amountOf(ev *models.Event) Amount {
+	return Amount{Currency: ev.Currency, Quantity: new(decimal.Big).Abs(ev.Quantity.Big)}
+}
+
+// baseAmountOf returns the value of an event, or zero if it is valued at the market price
+func baseAmountOf(ev *models.Event) Amount {
+	if ev.BaseCurrency == ev.Currency && ev.BaseQuantity.Big.Cmp(ev.Quantity.Big) == 0 {
+		return Amount{}
+	}
+	return Amount{Currency: ev.BaseCurrency, Quantity: new(decimal.Big).Copy(ev.BaseQuantity.Big)}
+}
+
+func labelOr(label, def string) string {
+	if label == "" {
+		return def
+	}
+	return label
+}
@@ -0,0 +1,93 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package eupholio
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/eupholio/eupholio/models"
+)
+
+// DefaultPortfolioName is the name of the portfolio of data which is not given any portfolio
+const DefaultPortfolioName = "default"
+
+type portfolioKey struct{}
+
+// NewDefaultPortfolio returns the default portfolio, whose id is 0, in the default fiat and timezone of ctx
+func NewDefaultPortfolio(ctx context.Context) *models.Portfolio {
+	defaults := DefaultsOf(ctx)
+	return &models.Portfolio{
+		ID:       0,
+		Name:     DefaultPortfolioName,
+		Fiat:     defaults.Fiat,
+		Timezone: defaults.Timezone,
+	}
+}
+
+// FindPortfolio finds a portfolio by name, or the default portfolio if name is empty.
+// The default portfolio is found even if it has not been configured.
+func FindPortfolio(ctx context.Context, db boil.ContextExecutor, name string) (*models.Portfolio, error) {
+	if name == "" {
+		name = DefaultPortfolioName
+	}
+	portfolio, err := models.Portfolios(models.PortfolioWhere.Name.EQ(name)).One(ctx, db)
+	if err == sql.ErrNoRows {
+		if name == DefaultPortfolioName {
+			return NewDefaultPortfolio(ctx), nil
+		}
+		return nil, fmt.Errorf("portfolio %s not found. create it with config portfolio", name)
+	}
+	return portfolio, err
+}
+
+// WithPortfolio returns a context which scopes reads and writes of repositories to a portfolio
+func WithPortfolio(ctx context.Context, portfolio *models.Portfolio) context.Context {
+	return context.WithValue(ctx, portfolioKey{}, portfolio)
+}
+
+// PortfolioOf returns the portfolio of a context, which is the default portfolio if not given
+func PortfolioOf(ctx context.Context) *models.Portfolio {
+	if portfolio, ok := ctx.Value(portfolioKey{}).(*models.Portfolio); ok && portfolio != nil {
+		return portfolio
+	}
+	return NewDefaultPortfolio(ctx)
+}
+
+// PortfolioID returns the id of the portfolio of a context
+func PortfolioID(ctx context.Context) int {
+	return PortfolioOf(ctx).ID
+}
+
+// InPortfolio returns a query mod which selects rows of the portfolio of a context
+func InPortfolio(ctx context.Context) qm.QueryMod {
+	return qm.Where("portfolio_id = ?", PortfolioID(ctx))
+}
+
+// PortfolioLocation returns the timezone of a portfolio
+func PortfolioLocation(portfolio *models.Portfolio) (*time.Location, error) {
+	return time.LoadLocation(portfolio.Timezone)
+}
@@ -0,0 +1,39 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package eupholio
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eupholio/eupholio/models"
+)
+
+func TestPortfolioOf(t *testing.T) {
+	ctx := context.Background()
+	if p := PortfolioOf(ctx); p.ID != 0 || p.Name != DefaultPortfolioName {
+		t.Errorf("the default portfolio expected, got %+v", p)
+	}
+	ctx = WithPortfolio(ctx, &models.Portfolio{ID: 2, Name: "corp", Fiat: "USD", Timezone: "UTC"})
+	if id := PortfolioID(ctx); id != 2 {
+		t.Errorf("portfolio 2 expected, got %d", id)
+	}
+}
@@ -0,0 +1,235 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package eupholio
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"github.com/eupholio/eupholio/pkg/currency"
+)
+
+// Head is the head of a file to detect its type
+type Head struct {
+	Name   string   // base name of the file in lower case
+	BOM    bool     // whether the file starts with UTF-8 BOM
+	JSON   []string // keys of the first object of a JSON Lines file
+	Header []string // the first row of a CSV file
+}
+
+// CSVMatch matches CSV files whose header is valid
+func CSVMatch(validate func([]string) error) func(head *Head) bool {
+	return func(head *Head) bool {
+		return len(head.Header) > 0 && validate(head.Header) == nil
+	}
+}
+
+// LangMatch matches CSV files whose header is valid in one of languages or formats
+func LangMatch(validate func(string, []string) error, langs ...string) func(head *Head) bool {
+	return func(head *Head) bool {
+		for _, lang := range langs {
+			if len(head.Header) > 0 && validate(lang, head.Header) == nil {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// FileType is a type of files of an exchange which can be imported
+type FileType struct {
+	Name      string   // name of the type, which is empty if the exchange has only one type
+	Hints     []string // words in file names which resolve ambiguity
+	Match     func(head *Head) bool
+	Extractor func(loc *time.Location) Extractor
+
+	exchange *Exchange
+}
+
+// Exchange returns the exchange of the file type
+func (ft *FileType) Exchange() *Exchange {
+	return ft.exchange
+}
+
+// FullName returns the names of the exchange and the type (ex. bitflyer trade)
+func (ft *FileType) FullName() string {
+	if ft.Name == "" {
+		return ft.exchange.Name
+	}
+	return ft.exchange.Name + " " + ft.Name
+}
+
+// RawTable is a table of rows imported from files of an exchange
+type RawTable struct {
+	Name       string
+	TimeColumn string   // column of the time which the translator finds rows by
+	KeyColumns []string // columns which identify a row instead of all of its columns, such as an id given by the exchange
+}
+
+// Exchange is an exchange or a service whose data are imported and translated
+type Exchange struct {
+	Name            string   // name of the import command and the translator
+	Aliases         []string // other names of the import command
+	DisplayName     string
+	FileTypes       []*FileType
+	DefaultFileType string // type of files imported unless specified. Types are detected by headers if empty
+	Timezone        bool   // whether times in the files have no time zone
+	Translator      func(db boil.ContextExecutor, fiat currency.Symbol) Translator
+	RawTables       []RawTable        // tables translated by the translator
+	WalletCodes     map[string]string // short codes of wallet codes shown in reports
+}
+
+// FileType returns a file type of the exchange
+func (e *Exchange) FileType(name string) (*FileType, bool) {
+	for _, ft := range e.FileTypes {
+		if ft.Name == name {
+			return ft, true
+		}
+	}
+	return nil, false
+}
+
+// FileTypeNames returns names of the file types of the exchange
+func (e *Exchange) FileTypeNames() []string {
+	var names []string
+	for _, ft := range e.FileTypes {
+		names = append(names, ft.Name)
+	}
+	return names
+}
+
+var exchanges = make(map[string]*Exchange)
+
+// RegisterExchange registers an exchange. It is called in init of the package of the exchange.
+func RegisterExchange(e *Exchange) {
+	for _, name := range append([]string{e.Name}, e.Aliases...) {
+		if _, ok := LookupExchange(name); ok {
+			panic(fmt.Sprintf("exchange %s registered twice", name))
+		}
+	}
+	for _, ft := range e.FileTypes {
+		ft.exchange = e
+	}
+	exchanges[e.Name] = e
+}
+
+// LookupExchange returns a registered exchange by its name or alias
+func LookupExchange(name string) (*Exchange, bool) {
+	if e, ok := exchanges[name]; ok {
+		return e, true
+	}
+	for _, e := range exchanges {
+		for _, alias := range e.Aliases {
+			if alias == name {
+				return e, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// Exchanges returns registered exchanges sorted by their names
+func Exchanges() []*Exchange {
+	es := make([]*Exchange, 0, len(exchanges))
+	for _, e := range exchanges {
+		es = append(es, e)
+	}
+	sort.Slice(es, func(i, j int) bool { return es[i].Name < es[j].Name })
+	return es
+}
+
+// FileTypes returns file types of all registered exchanges
+func FileTypes() []*FileType {
+	var fts []*FileType
+	for _, e := range Exchanges() {
+		fts = append(fts, e.FileTypes...)
+	}
+	return fts
+}
+
+// RawTables returns raw tables of all registered exchanges
+func RawTables() []RawTable {
+	var tables []RawTable
+	for _, e := range Exchanges() {
+		tables = append(tables, e.RawTables...)
+	}
+	return tables
+}
+
+// LookupRawTable returns the raw table of a name
+func LookupRawTable(name string) (RawTable, bool) {
+	for _, table := range RawTables() {
+		if table.Name == name {
+			return table, true
+		}
+	}
+	return RawTable{}, false
+}
+
+// DetectFileType returns the type of a file whose head matches. A hint in the file name resolves ambiguity.
+func DetectFileType(head *Head, fileTypes []*FileType) (*FileType, error) {
+	var matched []*FileType
+	for _, ft := range fileTypes {
+		if ft.Match(head) {
+			matched = append(matched, ft)
+		}
+	}
+	if len(matched) > 1 {
+		var hinted []*FileType
+		for _, ft := range matched {
+			for _, hint := range ft.Hints {
+				if strings.Contains(head.Name, hint) {
+					hinted = append(hinted, ft)
+					break
+				}
+			}
+		}
+		if len(hinted) == 1 {
+			return hinted[0], nil
+		}
+		var names []string
+		for _, ft := range matched {
+			names = append(names, ft.FullName())
+		}
+		return nil, fmt.Errorf("ambiguous file type (%s), use the import command of the type", strings.Join(names, ", "))
+	}
+	if len(matched) == 0 {
+		if head.JSON != nil {
+			return nil, fmt.Errorf("unknown JSON keys %s", strings.Join(head.JSON, ","))
+		}
+		return nil, fmt.Errorf("unknown header %s", strings.Join(head.Header, ","))
+	}
+	return matched[0], nil
+}
+
+// ShortWalletCode returns the short code of a wallet code registered by an exchange, or the wallet code itself
+func ShortWalletCode(walletCode string) string {
+	for _, e := range exchanges {
+		if shortCode, ok := e.WalletCodes[walletCode]; ok {
+			return shortCode
+		}
+	}
+	return walletCode
+}
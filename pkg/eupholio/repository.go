@@ -25,8 +25,6 @@ import (
 	"sort"
 	"time"
 
-	"github.com/volatiletech/sqlboiler/v4/boil"
-
 	"github.com/eupholio/eupholio/models"
 	"github.com/eupholio/eupholio/pkg/currency"
 )
@@ -36,8 +34,11 @@ type ConfigRepository interface {
 }
 
 type TransactionRepository interface {
-	CreateTransaction(ctx context.Context, time time.Time, exchangeCode string, id int) (*models.Transaction, error)
+	CreateTransaction(ctx context.Context, time time.Time, exchangeCode, account string, id int) (*models.Transaction, error)
+	UpdateTransaction(ctx context.Context, transaction *models.Transaction) error
 	DeleteTransaction(ctx context.Context, exchangeCode string, start, end time.Time) (int64, error)
+	DeleteTransactions(ctx context.Context, transactions models.TransactionSlice) (int64, error)
+	FindTransactionsByWalletAndAccount(ctx context.Context, walletCode, account string, start, end time.Time) (models.TransactionSlice, error)
 	FindTransactionsByYear(ctx context.Context, year int, location *time.Location) (models.TransactionSlice, error)
 }
 
@@ -51,6 +52,7 @@ type EventRepository interface {
 
 type EntryRepository interface {
 	CreateEntries(ctx context.Context, entries models.EntrySlice) error
+	DeleteEntriesByStartAndEnd(ctx context.Context, start, end time.Time) (int64, error)
 	UpdateEntries(ctx context.Context, entries models.EntrySlice) error
 	FindEntriesByYear(ctx context.Context, year int, loc *time.Location) (models.EntrySlice, error)
 	FindEntriesByStartAndEnd(ctx context.Context, start, end time.Time) (models.EntrySlice, error)
@@ -65,12 +67,12 @@ type MarketPriceRepository interface {
 
 type BalanceRepository interface {
 	CreateBalances(ctx context.Context, balances models.BalanceSlice) error
+	DeleteBalancesByYear(ctx context.Context, year int) (int64, error)
 	FindBalanceByCurrencyAndYear(ctx context.Context, currency string, year int) (*models.Balance, error)
 	FindBalancesByYear(ctx context.Context, year int) (models.BalanceSlice, error)
 }
 
 type Repository interface {
-	boil.ContextExecutor
 	ConfigRepository
 	TransactionRepository
 	EventRepository
@@ -83,6 +85,7 @@ type EventsOfTransaction struct {
 	ID          int
 	Time        time.Time
 	WalletCode  string
+	Account     string
 	Events      models.EventSlice
 	Description string
 }
@@ -101,6 +104,7 @@ func FindEventsOfTransactions(ctx context.Context, repo interface {
 			ID:          t.ID,
 			Time:        t.Time,
 			WalletCode:  t.WalletCode,
+			Account:     t.Account,
 			Description: t.Description,
 		}
 	}
@@ -129,6 +133,7 @@ type EntriesOfTransaction struct {
 	ID          int
 	Time        time.Time
 	WalletCode  string
+	Account     string
 	Entries     models.EntrySlice
 	Description string
 }
@@ -147,6 +152,7 @@ func FindEntriesOfTransactions(ctx context.Context, repo interface {
 			ID:          t.ID,
 			Time:        t.Time,
 			WalletCode:  t.WalletCode,
+			Account:     t.Account,
 			Description: t.Description,
 		}
 	}
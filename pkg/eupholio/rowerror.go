@@ -0,0 +1,77 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package eupholio
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ColumnError is an error of a column of a row
+type ColumnError struct {
+	Column string
+	Err    error
+}
+
+func (e *ColumnError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Column, e.Err.Error())
+}
+
+func (e *ColumnError) Unwrap() error {
+	return e.Err
+}
+
+// RowError is an error of a row of an imported file
+type RowError struct {
+	Line   int
+	Column string
+	Err    error
+}
+
+// NewRowError creates an error of a row, whose column is taken from a ColumnError if any
+func NewRowError(line int, err error) *RowError {
+	e := &RowError{Line: line, Err: err}
+	var ce *ColumnError
+	if errors.As(err, &ce) {
+		e.Column = ce.Column
+		e.Err = ce.Err
+	}
+	return e
+}
+
+func (e *RowError) Error() string {
+	if e.Column != "" {
+		return fmt.Sprintf("line %d: %s: %s", e.Line, e.Column, e.Err.Error())
+	}
+	return fmt.Sprintf("line %d: %s", e.Line, e.Err.Error())
+}
+
+// RowErrors reports all rows which cannot be imported
+type RowErrors []*RowError
+
+func (es RowErrors) Error() string {
+	ss := make([]string, 0, len(es))
+	for _, e := range es {
+		ss = append(ss, e.Error())
+	}
+	return fmt.Sprintf("%d rows cannot be mapped:\n%s", len(es), strings.Join(ss, "\n"))
+}
@@ -0,0 +1,52 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package eupholio
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestHandleRowErrors(t *testing.T) {
+	errs := RowErrors{
+		NewRowError(2, &ColumnError{Column: "Amount", Err: errors.New("invalid decimal x")}),
+		NewRowError(5, errors.New("unknown type")),
+	}
+	if errs[0].Column != "Amount" || errs[0].Error() != "line 2: Amount: invalid decimal x" {
+		t.Errorf("unexpected error %s", errs[0])
+	}
+
+	strict := &Config{}
+	if err := strict.HandleRowErrors(errs); err == nil {
+		t.Error("rows must be rejected in strict mode")
+	} else if rowErrs, ok := err.(RowErrors); !ok || len(rowErrs) != 2 {
+		t.Errorf("all rows must be reported but %v", err)
+	}
+
+	lenient := &Config{}
+	LenientOption()(lenient)
+	if err := lenient.HandleRowErrors(errs); err != nil {
+		t.Fatal(err)
+	}
+	if len(lenient.Batch.Rejected) != 2 {
+		t.Errorf("expected 2 rejected rows but %d", len(lenient.Batch.Rejected))
+	}
+}
@@ -0,0 +1,35 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package exchanges registers all exchanges and services supported by Eupholio.
+// Import it for the side effect, and add the package of a new exchange here.
+package exchanges
+
+import (
+	_ "github.com/eupholio/eupholio/pkg/bitflyer"
+	_ "github.com/eupholio/eupholio/pkg/bittrex"
+	_ "github.com/eupholio/eupholio/pkg/coincheck"
+	_ "github.com/eupholio/eupholio/pkg/cointracking"
+	_ "github.com/eupholio/eupholio/pkg/cryptact"
+	_ "github.com/eupholio/eupholio/pkg/koinly"
+	_ "github.com/eupholio/eupholio/pkg/ledger"
+	_ "github.com/eupholio/eupholio/pkg/normalized"
+	_ "github.com/eupholio/eupholio/pkg/poloniex"
+)
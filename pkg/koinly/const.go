@@ -0,0 +1,108 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package koinly
+
+import "github.com/eupholio/eupholio/pkg/eupholio"
+
+const WalletCode = "KOINLY"
+
+// Column names of the universal format
+const (
+	DateColumn             = "Date"
+	SentAmountColumn       = "Sent Amount"
+	SentCurrencyColumn     = "Sent Currency"
+	ReceivedAmountColumn   = "Received Amount"
+	ReceivedCurrencyColumn = "Received Currency"
+	FeeAmountColumn        = "Fee Amount"
+	FeeCurrencyColumn      = "Fee Currency"
+	NetWorthAmountColumn   = "Net Worth Amount"
+	NetWorthCurrencyColumn = "Net Worth Currency"
+	LabelColumn            = "Label"
+	DescriptionColumn      = "Description"
+	TxHashColumn           = "TxHash"
+)
+
+var columnNames = []string{
+	DateColumn,
+	SentAmountColumn,
+	SentCurrencyColumn,
+	ReceivedAmountColumn,
+	ReceivedCurrencyColumn,
+	FeeAmountColumn,
+	FeeCurrencyColumn,
+	NetWorthAmountColumn,
+	NetWorthCurrencyColumn,
+	LabelColumn,
+	DescriptionColumn,
+	TxHashColumn,
+}
+
+var columnNamesSet map[string]struct{}
+
+func init() {
+	columnNamesSet = make(map[string]struct{})
+	for _, name := range columnNames {
+		columnNamesSet[name] = struct{}{}
+	}
+}
+
+// Labels
+const (
+	LabelAirdrop      = "airdrop"
+	LabelFork         = "fork"
+	LabelMining       = "mining"
+	LabelReward       = "reward"
+	LabelIncome       = "income"
+	LabelLoanInterest = "loan interest"
+	LabelOtherIncome  = "other income"
+	LabelStaking      = "staking"
+	LabelGift         = "gift"
+	LabelLost         = "lost"
+	LabelCost         = "cost"
+	LabelMarginFee    = "margin fee"
+	LabelDonation     = "donation"
+	LabelRealizedGain = "realized gain"
+	LabelSwap         = "swap"
+)
+
+// incomeLabels maps labels of received amounts to income labels of eupholio
+var incomeLabels = map[string]string{
+	LabelAirdrop:      eupholio.LabelAirdrop,
+	LabelFork:         eupholio.LabelAirdrop,
+	LabelMining:       eupholio.LabelMining,
+	LabelReward:       eupholio.LabelIncome,
+	LabelIncome:       eupholio.LabelIncome,
+	LabelLoanInterest: eupholio.LabelLending,
+	LabelOtherIncome:  eupholio.LabelIncome,
+	LabelStaking:      eupholio.LabelStaking,
+	LabelGift:         LabelGift,
+	LabelRealizedGain: eupholio.LabelIncome,
+}
+
+// outflowLabels are labels of sent amounts which are disposed as fees
+var outflowLabels = map[string]struct{}{
+	LabelGift:         {},
+	LabelLost:         {},
+	LabelCost:         {},
+	LabelMarginFee:    {},
+	LabelDonation:     {},
+	LabelRealizedGain: {},
+}
@@ -0,0 +1,124 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package koinly
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+// exportLabels maps income and fee labels of eupholio to labels of the universal format
+var exportLabels = map[eupholio.MovementType]map[string]string{
+	eupholio.MovementIncome: {
+		eupholio.LabelMining:  LabelMining,
+		eupholio.LabelStaking: LabelStaking,
+		eupholio.LabelLending: LabelLoanInterest,
+		eupholio.LabelAirdrop: LabelAirdrop,
+		LabelGift:             LabelGift,
+	},
+	eupholio.MovementFee: {
+		LabelGift:          LabelGift,
+		LabelLost:          LabelLost,
+		LabelDonation:      LabelDonation,
+		"margin":           LabelMarginFee,
+		eupholio.LabelCost: LabelCost,
+	},
+}
+
+// Exporter writes events of transactions as the universal format
+type Exporter struct {
+}
+
+// NewExporter create an exporter of the universal format
+func NewExporter() *Exporter {
+	return &Exporter{}
+}
+
+// Export writes rows translated from transactions, and returns the number of transactions which cannot be exported
+func (e *Exporter) Export(writer io.Writer, transactions []*eupholio.EventsOfTransaction) (int, error) {
+	w := csv.NewWriter(writer)
+	if err := w.Write(columnNames); err != nil {
+		return 0, err
+	}
+	skipped := 0
+	for _, tr := range transactions {
+		rows, err := e.Rows(tr)
+		if err != nil {
+			log.Printf("skip transaction %d (%s %s): %v", tr.ID, tr.WalletCode, tr.Description, err)
+			skipped++
+			continue
+		}
+		for _, row := range rows {
+			if err := w.Write(row); err != nil {
+				return skipped, err
+			}
+		}
+	}
+	w.Flush()
+	return skipped, w.Error()
+}
+
+// Rows translates events of a transaction to rows of the universal format
+func (e *Exporter) Rows(tr *eupholio.EventsOfTransaction) ([][]string, error) {
+	ms, err := eupholio.Movements(tr)
+	if err != nil {
+		return nil, err
+	}
+	var rows [][]string
+	for _, m := range ms {
+		label := ""
+		switch m.Type {
+		case eupholio.MovementIncome:
+			label = LabelIncome
+		case eupholio.MovementFee:
+			label = LabelCost
+		}
+		if l, ok := exportLabels[m.Type][m.Label]; ok {
+			label = l
+		}
+		rows = append(rows, []string{
+			tr.Time.UTC().Format(recordTimeFormat),
+			quantity(m.Sent),
+			m.Sent.Currency,
+			quantity(m.Received),
+			m.Received.Currency,
+			quantity(m.Fee),
+			m.Fee.Currency,
+			quantity(m.Value),
+			m.Value.Currency,
+			label,
+			tr.Description,
+			"",
+		})
+	}
+	return rows, nil
+}
+
+func quantity(a eupholio.Amount) string {
+	if a.Quantity == nil {
+		return ""
+	}
+	return fmt.Sprintf("%f", a.Quantity)
+}
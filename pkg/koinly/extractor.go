@@ -0,0 +1,235 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package koinly
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+const recordTimeFormat = "2006-01-02 15:04:05 UTC"
+
+// recordTimeFormats are accepted formats of the date column, which is in UTC
+var recordTimeFormats = []string{
+	recordTimeFormat,
+	"2006-01-02 15:04 UTC",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+}
+
+// Extractor for Koinly universal files
+type Extractor struct {
+}
+
+// NewExtractor create an extractor for Koinly universal files
+func NewExtractor() *Extractor {
+	return &Extractor{}
+}
+
+// Execute performs ETL
+func (e *Extractor) Execute(ctx context.Context, db boil.ContextExecutor, reader io.Reader, options ...eupholio.Option) error {
+	config := &eupholio.Config{}
+	for _, o := range options {
+		o(config)
+	}
+
+	transactions, err := e.extract(reader)
+	if errs, ok := err.(eupholio.RowErrors); ok {
+		err = config.HandleRowErrors(errs)
+	}
+	if err != nil {
+		return err
+	}
+	for _, tr := range transactions {
+		err := config.InsertRow(ctx, db, models.TableNames.KoinlyTransactions, tr)
+		if err != nil {
+			if config.Debug {
+				log.Print(tr)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// extract extracts transactions from a reader, and reports all rows which cannot be mapped to movements
+func (e *Extractor) extract(reader io.Reader) (models.KoinlyTransactionSlice, error) {
+	rows, err := extractRecords(reader)
+	if err != nil {
+		return nil, &eupholio.RowError{Line: 1, Err: err}
+	}
+
+	var transactions models.KoinlyTransactionSlice
+	var errs eupholio.RowErrors
+
+	for i, row := range rows {
+		n := i + 2
+		tr, err := row.Transaction()
+		if err == nil {
+			_, err = ToMovement(tr)
+		}
+		if err != nil {
+			errs = append(errs, &eupholio.RowError{Line: n, Err: err})
+			continue
+		}
+		transactions = append(transactions, tr)
+	}
+	if len(errs) > 0 {
+		return transactions, errs
+	}
+
+	return transactions, nil
+}
+
+func extractRecords(reader io.Reader) ([]Record, error) {
+	r := csv.NewReader(reader)
+
+	csvHead, err := r.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ValidateColumnNames(csvHead); err != nil {
+		return nil, err
+	}
+
+	csvRows, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	return MakeRecords(csvHead, csvRows)
+}
+
+// ValidateColumnNames checks columns
+func ValidateColumnNames(names []string) error {
+	remains := make(map[string]struct{})
+	for k := range columnNamesSet {
+		remains[k] = struct{}{}
+	}
+	for _, n := range names {
+		if _, ok := columnNamesSet[n]; !ok {
+			return fmt.Errorf("unknown column %s found", n)
+		}
+		delete(remains, n)
+	}
+	if len(remains) > 0 {
+		for k := range remains {
+			return fmt.Errorf("column %s not found", k)
+		}
+	}
+	return nil
+}
+
+func MakeRecords(head []string, rows [][]string) ([]Record, error) {
+	records := make([]Record, 0, len(rows))
+	for _, row := range rows {
+		record := make(Record)
+		for i, col := range head {
+			record[col] = row[i]
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+type Record map[string]string
+
+func (r Record) Get(name string) string {
+	return strings.TrimSpace(r[name])
+}
+
+func (r Record) Date() (time.Time, error) {
+	date := r.Get(DateColumn)
+	for _, layout := range recordTimeFormats {
+		if t, err := time.ParseInLocation(layout, date, time.UTC); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date '%s' in %s column", date, DateColumn)
+}
+
+// GetAsDecimal returns a decimal value, or zero for an empty column
+func (r Record) GetAsDecimal(name string) (*decimal.Big, error) {
+	s := r.Get(name)
+	if len(s) == 0 {
+		return decimal.New(0, 0), nil
+	}
+	if b, ok := new(decimal.Big).SetString(s); ok {
+		return b, nil
+	}
+	return nil, fmt.Errorf("invalid decimal '%s' in %s column", s, name)
+}
+
+// Transaction makes a row of koinly_transactions
+func (r Record) Transaction() (*models.KoinlyTransaction, error) {
+	date, err := r.Date()
+	if err != nil {
+		return nil, err
+	}
+	sent, err := r.GetAsDecimal(SentAmountColumn)
+	if err != nil {
+		return nil, err
+	}
+	received, err := r.GetAsDecimal(ReceivedAmountColumn)
+	if err != nil {
+		return nil, err
+	}
+	fee, err := r.GetAsDecimal(FeeAmountColumn)
+	if err != nil {
+		return nil, err
+	}
+	tr := &models.KoinlyTransaction{
+		Date:             date,
+		SentAmount:       types.NewDecimal(sent),
+		SentCurrency:     r.Get(SentCurrencyColumn),
+		ReceivedAmount:   types.NewDecimal(received),
+		ReceivedCurrency: r.Get(ReceivedCurrencyColumn),
+		FeeAmount:        types.NewDecimal(fee),
+		FeeCurrency:      r.Get(FeeCurrencyColumn),
+		Label:            strings.ToLower(r.Get(LabelColumn)),
+		Description:      r.Get(DescriptionColumn),
+		TXHash:           r.Get(TxHashColumn),
+	}
+	if s := r.Get(NetWorthAmountColumn); len(s) > 0 {
+		netWorth, err := r.GetAsDecimal(NetWorthAmountColumn)
+		if err != nil {
+			return nil, err
+		}
+		tr.NetWorthAmount = types.NewNullDecimal(netWorth)
+		tr.NetWorthCurrency = null.StringFrom(r.Get(NetWorthCurrencyColumn))
+	}
+	return tr, nil
+}
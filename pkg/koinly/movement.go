@@ -0,0 +1,80 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package koinly
+
+import (
+	"fmt"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+// ToMovement maps a transaction to a movement by the label and the sides of the transaction
+func ToMovement(tr *models.KoinlyTransaction) (*eupholio.Movement, error) {
+	m := &eupholio.Movement{
+		Sent:     eupholio.Amount{Currency: tr.SentCurrency, Quantity: tr.SentAmount.Big},
+		Received: eupholio.Amount{Currency: tr.ReceivedCurrency, Quantity: tr.ReceivedAmount.Big},
+		Fee:      eupholio.Amount{Currency: tr.FeeCurrency, Quantity: tr.FeeAmount.Big},
+	}
+	if tr.NetWorthAmount.Big != nil && tr.NetWorthCurrency.Valid {
+		m.Value = eupholio.Amount{Currency: tr.NetWorthCurrency.String, Quantity: tr.NetWorthAmount.Big}
+	}
+	if !m.Fee.IsZero() && m.Fee.Quantity.Sign() < 0 {
+		return nil, fmt.Errorf("negative fee %s", m.Fee.Quantity)
+	}
+
+	sent, received := !m.Sent.IsZero(), !m.Received.IsZero()
+	switch {
+	case sent && received:
+		if tr.Label != "" && tr.Label != LabelSwap {
+			return nil, fmt.Errorf("label '%s' cannot be used for a trade", tr.Label)
+		}
+		m.Type = eupholio.MovementTrade
+	case received:
+		if tr.Label == "" {
+			m.Type = eupholio.MovementDeposit
+			break
+		}
+		label, ok := incomeLabels[tr.Label]
+		if !ok {
+			return nil, fmt.Errorf("label '%s' cannot be used for a deposit", tr.Label)
+		}
+		m.Type, m.Label = eupholio.MovementIncome, label
+	case sent:
+		if tr.Label == "" {
+			m.Type = eupholio.MovementWithdraw
+			break
+		}
+		if _, ok := outflowLabels[tr.Label]; !ok {
+			return nil, fmt.Errorf("label '%s' cannot be used for a withdrawal", tr.Label)
+		}
+		m.Type, m.Label = eupholio.MovementFee, tr.Label
+	case !m.Fee.IsZero(): // fee only
+		m.Type, m.Label = eupholio.MovementFee, eupholio.LabelCost
+		m.Sent, m.Fee = m.Fee, eupholio.Amount{}
+	default:
+		return nil, fmt.Errorf("neither sent nor received amount is specified")
+	}
+	if (sent && m.Sent.Quantity.Sign() < 0) || (received && m.Received.Quantity.Sign() < 0) {
+		return nil, fmt.Errorf("negative amount")
+	}
+	return m, nil
+}
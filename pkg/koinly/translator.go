@@ -0,0 +1,107 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package koinly
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/currency"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+// Translator is a translator for Koinly
+type Translator struct {
+	repository   Repository
+	mainCurrency currency.Symbol
+}
+
+// NewTranslator create a translator for Koinly
+func NewTranslator(repo Repository, mainCurrency currency.Symbol) *Translator {
+	return &Translator{
+		repository:   repo,
+		mainCurrency: mainCurrency,
+	}
+}
+
+// Translate stores extracted transaction data to transaction table
+func (t *Translator) Translate(ctx context.Context, repo eupholio.Repository, start, end time.Time) error {
+	koinlyRepository := t.repository
+
+	n, err := repo.DeleteTransaction(ctx, WalletCode, start, end)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		log.Println("deleted", n, "entries from events")
+	}
+
+	trs, err := koinlyRepository.FindTransactions(ctx, start, end)
+	if err != nil {
+		return err
+	}
+	if len(trs) == 0 {
+		log.Println("no transction found")
+	}
+
+	var events []*models.Event
+
+	for _, tr := range trs {
+		transaction, err := repo.CreateTransaction(ctx, tr.Date, WalletCode, tr.Account, tr.ID)
+		if err != nil {
+			return err
+		}
+
+		es, desc, err := t.TranslateTransaction(transaction, tr)
+		if err != nil {
+			return err
+		}
+		transaction.Description = desc
+		if err := repo.UpdateTransaction(ctx, transaction); err != nil {
+			return err
+		}
+
+		events = append(events, es...)
+	}
+
+	err = repo.CreateEvents(ctx, events)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// TranslateTransaction translates a transaction of the universal format to events
+func (t *Translator) TranslateTransaction(transaction *models.Transaction, tr *models.KoinlyTransaction) (models.EventSlice, string, error) {
+	m, err := ToMovement(tr)
+	if err != nil {
+		return nil, "", fmt.Errorf("koinly transaction %d: %w", tr.ID, err)
+	}
+	events, err := m.Events(t.mainCurrency.String(), eupholio.NewEventFunc(tr.Date, transaction.ID))
+	if err != nil {
+		return nil, "", fmt.Errorf("koinly transaction %d: %w", tr.ID, err)
+	}
+	return events, m.Description(), nil
+}
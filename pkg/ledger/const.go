@@ -0,0 +1,107 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package ledger imports the eupholio ledger format, which is a CSV or JSON Lines file of
+// movements of assets. Rows which have the same id in a wallet form a transaction.
+//
+// Fields (version 1):
+//
+//	version           format version (1)
+//	id                transaction id
+//	time              RFC 3339 time with zone (e.g. 2020-01-02T10:00:00+09:00)
+//	wallet            wallet or counterparty name
+//	type              buy, sell, income, fee, deposit or withdraw
+//	currency          currency of the movement
+//	quantity          quantity of the movement
+//	counter_currency  currency paid (buy), received (sell) or the value currency (income, fee)
+//	counter_quantity  quantity of the counter currency (optional for income and fee)
+//	fee_currency      currency of the fee (optional)
+//	fee_quantity      quantity of the fee (optional)
+//	description       description (optional)
+package ledger
+
+const WalletCode = "LEDGER"
+
+// Versions
+const (
+	Version1      = 1
+	LatestVersion = Version1
+)
+
+// Column names (and keys of JSON objects)
+const (
+	VersionColumn         = "version"
+	IDColumn              = "id"
+	TimeColumn            = "time"
+	WalletColumn          = "wallet"
+	TypeColumn            = "type"
+	CurrencyColumn        = "currency"
+	QuantityColumn        = "quantity"
+	CounterCurrencyColumn = "counter_currency"
+	CounterQuantityColumn = "counter_quantity"
+	FeeCurrencyColumn     = "fee_currency"
+	FeeQuantityColumn     = "fee_quantity"
+	DescriptionColumn     = "description"
+)
+
+var columnNames = []string{
+	VersionColumn,
+	IDColumn,
+	TimeColumn,
+	WalletColumn,
+	TypeColumn,
+	CurrencyColumn,
+	QuantityColumn,
+	CounterCurrencyColumn,
+	CounterQuantityColumn,
+	FeeCurrencyColumn,
+	FeeQuantityColumn,
+	DescriptionColumn,
+}
+
+// requiredColumns must be specified in all rows
+var requiredColumns = []string{
+	VersionColumn,
+	IDColumn,
+	TimeColumn,
+	WalletColumn,
+	TypeColumn,
+	CurrencyColumn,
+	QuantityColumn,
+}
+
+var columnNamesSet map[string]struct{}
+
+func init() {
+	columnNamesSet = make(map[string]struct{})
+	for _, name := range columnNames {
+		columnNamesSet[name] = struct{}{}
+	}
+}
+
+// Types
+const (
+	TypeBuy      = "buy"
+	TypeSell     = "sell"
+	TypeIncome   = "income"
+	TypeFee      = "fee"
+	TypeDeposit  = "deposit"
+	TypeWithdraw = "withdraw"
+)
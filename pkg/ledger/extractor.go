@@ -0,0 +1,307 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package ledger
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+// Extractor for the eupholio ledger format
+type Extractor struct {
+}
+
+// NewExtractor create an extractor for the eupholio ledger format
+func NewExtractor() *Extractor {
+	return &Extractor{}
+}
+
+// Execute performs ETL
+func (e *Extractor) Execute(ctx context.Context, db boil.ContextExecutor, reader io.Reader, options ...eupholio.Option) error {
+	config := &eupholio.Config{}
+	for _, o := range options {
+		o(config)
+	}
+
+	entries, err := e.extract(reader)
+	if errs, ok := err.(eupholio.RowErrors); ok {
+		err = config.HandleRowErrors(errs)
+	}
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		err := config.InsertRow(ctx, db, models.TableNames.LedgerEntries, entry)
+		if err != nil {
+			if config.Debug {
+				log.Print(entry)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// extract extracts entries from a CSV or JSON Lines reader, and reports all rows which cannot be imported
+func (e *Extractor) extract(reader io.Reader) (models.LedgerEntrySlice, error) {
+	r := bufio.NewReader(reader)
+	var records []Record
+	var err error
+	if isJSONLines(r) {
+		records, err = extractJSONLines(r)
+	} else {
+		records, err = extractCSV(r)
+	}
+	errs, ok := err.(eupholio.RowErrors)
+	if !ok && err != nil {
+		return nil, err
+	}
+
+	var entries models.LedgerEntrySlice
+	times := make(map[[2]string]time.Time) // time of each transaction
+
+	for _, record := range records {
+		entry, err := record.Entry()
+		if err == nil {
+			_, err = ToMovement(entry)
+		}
+		if err == nil {
+			key := [2]string{entry.Wallet, entry.Tid}
+			if t, ok := times[key]; ok && !t.Equal(entry.Time) {
+				err = fmt.Errorf("time of transaction %s differs from %s", entry.Tid, t.Format(time.RFC3339))
+			}
+			times[key] = entry.Time
+		}
+		if err != nil {
+			errs = append(errs, &eupholio.RowError{Line: record.line, Err: err})
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	if len(errs) > 0 {
+		sort.SliceStable(errs, func(i, j int) bool { return errs[i].Line < errs[j].Line })
+		return entries, errs
+	}
+
+	return entries, nil
+}
+
+// isJSONLines reports whether the first non-space character is the beginning of a JSON object
+func isJSONLines(r *bufio.Reader) bool {
+	for n := 1; ; n++ {
+		b, err := r.Peek(n)
+		if len(b) < n {
+			return false
+		}
+		c := b[n-1]
+		switch {
+		case c == '{':
+			return true
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			if err != nil {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+}
+
+func extractCSV(reader io.Reader) ([]Record, error) {
+	r := csv.NewReader(reader)
+
+	csvHead, err := r.Read()
+	if err != nil {
+		return nil, &eupholio.RowError{Line: 1, Err: err}
+	}
+	if err := ValidateColumnNames(csvHead); err != nil {
+		return nil, &eupholio.RowError{Line: 1, Err: err}
+	}
+	csvRows, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]Record, 0, len(csvRows))
+	for i, row := range csvRows {
+		values := make(map[string]string)
+		for j, col := range csvHead {
+			values[col] = row[j]
+		}
+		records = append(records, Record{line: i + 2, values: values})
+	}
+	return records, nil
+}
+
+func extractJSONLines(reader io.Reader) ([]Record, error) {
+	var records []Record
+	var errs eupholio.RowErrors
+	scanner := bufio.NewScanner(reader)
+	for n := 1; scanner.Scan(); n++ {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		values, err := decodeJSONLine(line)
+		if err != nil {
+			errs = append(errs, &eupholio.RowError{Line: n, Err: err})
+			continue
+		}
+		records = append(records, Record{line: n, values: values})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(errs) > 0 {
+		return records, errs
+	}
+	return records, nil
+}
+
+func decodeJSONLine(line []byte) (map[string]string, error) {
+	d := json.NewDecoder(bytes.NewReader(line))
+	d.UseNumber()
+	var object map[string]interface{}
+	if err := d.Decode(&object); err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(object))
+	values := make(map[string]string)
+	for k, v := range object {
+		names = append(names, k)
+		switch v := v.(type) {
+		case nil:
+		case string:
+			values[k] = v
+		case json.Number:
+			values[k] = v.String()
+		default:
+			return nil, fmt.Errorf("invalid value of %s", k)
+		}
+	}
+	if err := ValidateColumnNames(names); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// ValidateColumnNames checks columns
+func ValidateColumnNames(names []string) error {
+	found := make(map[string]struct{})
+	for _, n := range names {
+		if _, ok := columnNamesSet[n]; !ok {
+			return fmt.Errorf("unknown column %s found", n)
+		}
+		found[n] = struct{}{}
+	}
+	for _, n := range requiredColumns {
+		if _, ok := found[n]; !ok {
+			return fmt.Errorf("column %s not found", n)
+		}
+	}
+	return nil
+}
+
+// Record is a row of a CSV file or an object of a JSON Lines file
+type Record struct {
+	line   int
+	values map[string]string
+}
+
+func (r Record) Get(name string) string {
+	return strings.TrimSpace(r.values[name])
+}
+
+// GetAsDecimal returns a decimal value, or nil for an empty column
+func (r Record) GetAsDecimal(name string) (*decimal.Big, error) {
+	s := r.Get(name)
+	if len(s) == 0 {
+		return nil, nil
+	}
+	if b, ok := new(decimal.Big).SetString(s); ok {
+		return b, nil
+	}
+	return nil, fmt.Errorf("invalid decimal '%s' in %s column", s, name)
+}
+
+// Entry makes a row of ledger_entries
+func (r Record) Entry() (*models.LedgerEntry, error) {
+	for _, name := range requiredColumns {
+		if len(r.Get(name)) == 0 {
+			return nil, fmt.Errorf("%s is not specified", name)
+		}
+	}
+	version, err := strconv.Atoi(r.Get(VersionColumn))
+	if err != nil || version < Version1 || version > LatestVersion {
+		return nil, fmt.Errorf("unsupported version '%s'", r.Get(VersionColumn))
+	}
+	tm, err := time.Parse(time.RFC3339, r.Get(TimeColumn))
+	if err != nil {
+		return nil, fmt.Errorf("invalid time '%s' in %s column", r.Get(TimeColumn), TimeColumn)
+	}
+	quantity, err := r.GetAsDecimal(QuantityColumn)
+	if err != nil {
+		return nil, err
+	}
+	counterQuantity, err := r.GetAsDecimal(CounterQuantityColumn)
+	if err != nil {
+		return nil, err
+	}
+	feeQuantity, err := r.GetAsDecimal(FeeQuantityColumn)
+	if err != nil {
+		return nil, err
+	}
+	if feeQuantity == nil {
+		feeQuantity = decimal.New(0, 0)
+	}
+	return &models.LedgerEntry{
+		Version:         version,
+		Tid:             r.Get(IDColumn),
+		Time:            tm.UTC(),
+		Wallet:          r.Get(WalletColumn),
+		Type:            strings.ToLower(r.Get(TypeColumn)),
+		Currency:        r.Get(CurrencyColumn),
+		Quantity:        types.NewDecimal(quantity),
+		CounterCurrency: r.Get(CounterCurrencyColumn),
+		CounterQuantity: types.NewNullDecimal(counterQuantity),
+		FeeCurrency:     r.Get(FeeCurrencyColumn),
+		FeeQuantity:     types.NewDecimal(feeQuantity),
+		Description:     r.Get(DescriptionColumn),
+	}, nil
+}
@@ -0,0 +1,113 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package ledger
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/currency"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+var testLedgerCsv = `version,id,time,wallet,type,currency,quantity,counter_currency,counter_quantity,fee_currency,fee_quantity,description
+1,otc-1,2020-01-02T10:00:00+09:00,OTC,buy,BTC,0.1,JPY,80000,JPY,100,
+1,p2p-1,2020-01-03T01:00:00Z,P2P,sell,BTC,0.05,JPY,45000,,,sold to a friend
+1,p2p-1,2020-01-03T01:00:00Z,P2P,fee,BTC,0.0001,,,,,
+1,p2p-2,2020-01-04T01:00:00Z,P2P,income,ETH,0.5,JPY,10000,,,
+`
+
+var testLedgerJSONLines = `
+{"version":1,"id":"otc-1","time":"2020-01-02T10:00:00+09:00","wallet":"OTC","type":"buy","currency":"BTC","quantity":"0.1","counter_currency":"JPY","counter_quantity":80000,"fee_currency":"JPY","fee_quantity":"100"}
+{"version":1,"id":"p2p-1","time":"2020-01-03T01:00:00Z","wallet":"P2P","type":"sell","currency":"BTC","quantity":"0.05","counter_currency":"JPY","counter_quantity":"45000","description":"sold to a friend"}
+{"version":1,"id":"p2p-1","time":"2020-01-03T01:00:00Z","wallet":"P2P","type":"fee","currency":"BTC","quantity":"0.0001"}
+
+{"version":1,"id":"p2p-2","time":"2020-01-04T01:00:00Z","wallet":"P2P","type":"income","currency":"ETH","quantity":0.5,"counter_currency":"JPY","counter_quantity":10000,"fee_currency":null}
+`
+
+func translateAll(t *testing.T, entries models.LedgerEntrySlice) ([]string, models.EventSlice) {
+	translator := NewTranslator(nil, currency.JPY)
+	var descs []string
+	var all models.EventSlice
+	for i, group := range GroupEntries(entries) {
+		transaction := &models.Transaction{ID: i + 1, Time: group[0].Time}
+		events, desc, err := translator.TranslateTransaction(transaction, group)
+		if err != nil {
+			t.Fatal(err)
+		}
+		descs = append(descs, desc)
+		all = append(all, events...)
+	}
+	return descs, all
+}
+
+func TestExtractCSVAndJSONLines(t *testing.T) {
+	csvEntries, err := NewExtractor().extract(strings.NewReader(testLedgerCsv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	jsonEntries, err := NewExtractor().extract(strings.NewReader(testLedgerJSONLines))
+	if err != nil {
+		t.Fatal(err)
+	}
+	descs, expected := translateAll(t, csvEntries)
+	_, actual := translateAll(t, jsonEntries)
+
+	if len(descs) != 3 || descs[1] != "P2P: sold to a friend; cost BTC" {
+		t.Errorf("unexpected descriptions %v", descs)
+	}
+	if len(expected) != 9 {
+		t.Fatalf("expected 9 events but %d", len(expected))
+	}
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d events but %d", len(expected), len(actual))
+	}
+	for i, e := range expected {
+		a := actual[i]
+		if a.Type != e.Type || a.Currency != e.Currency || a.BaseCurrency != e.BaseCurrency ||
+			a.Quantity.Big.Cmp(e.Quantity.Big) != 0 || a.BaseQuantity.Big.Cmp(e.BaseQuantity.Big) != 0 || !a.Time.Equal(e.Time) {
+			t.Errorf("event %d: expected %s %s %s %s %s but %s %s %s %s %s", i,
+				e.Type, e.Currency, e.Quantity.Big, e.BaseCurrency, e.BaseQuantity.Big,
+				a.Type, a.Currency, a.Quantity.Big, a.BaseCurrency, a.BaseQuantity.Big)
+		}
+	}
+	if buy := expected[1]; buy.Type != eupholio.EventTypeBuy || buy.Currency != "BTC" || buy.BaseCurrency != "JPY" || !buy.Time.Equal(csvEntries[0].Time) {
+		t.Errorf("unexpected buy event %v", buy)
+	}
+}
+
+func TestInvalidRows(t *testing.T) {
+	jsonl := `{"version":2,"id":"a","time":"2020-01-02T10:00:00Z","wallet":"OTC","type":"buy","currency":"BTC","quantity":"1"}
+{"version":1,"id":"b","time":"2020-01-02T10:00:00Z","wallet":"OTC","type":"swap","currency":"BTC","quantity":"1"}
+{"version":1,"id":"c","time":"2020-01-02 10:00:00","wallet":"OTC","type":"deposit","currency":"BTC","quantity":"1"}
+{"version":1,"id":"d","time":"2020-01-02T10:00:00Z","wallet":"OTC","type":"buy","currency":"BTC","quantity":"1"}
+{"version":1,"id":"e","time":"2020-01-02T10:00:00Z","wallet":"OTC","type":"deposit","currency":"BTC","quantity":"1"}
+`
+	_, err := NewExtractor().extract(strings.NewReader(jsonl))
+	errs, ok := err.(eupholio.RowErrors)
+	if !ok {
+		t.Fatalf("expected row errors but %v", err)
+	}
+	if len(errs) != 4 || errs[3].Line != 4 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+}
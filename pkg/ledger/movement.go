@@ -0,0 +1,67 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package ledger
+
+import (
+	"fmt"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+// ToMovement maps an entry to a movement by the type
+func ToMovement(entry *models.LedgerEntry) (*eupholio.Movement, error) {
+	amount := eupholio.Amount{Currency: entry.Currency, Quantity: entry.Quantity.Big}
+	counter := eupholio.Amount{Currency: entry.CounterCurrency, Quantity: entry.CounterQuantity.Big}
+	m := &eupholio.Movement{
+		Fee: eupholio.Amount{Currency: entry.FeeCurrency, Quantity: entry.FeeQuantity.Big},
+	}
+	if amount.IsZero() || amount.Quantity.Sign() < 0 {
+		return nil, fmt.Errorf("quantity should be positive")
+	}
+	if !counter.IsZero() && counter.Quantity.Sign() < 0 {
+		return nil, fmt.Errorf("counter quantity should not be negative")
+	}
+	if !m.Fee.IsZero() && m.Fee.Quantity.Sign() < 0 {
+		return nil, fmt.Errorf("fee quantity should not be negative")
+	}
+
+	switch entry.Type {
+	case TypeBuy:
+		m.Type, m.Sent, m.Received = eupholio.MovementTrade, counter, amount
+	case TypeSell:
+		m.Type, m.Sent, m.Received = eupholio.MovementTrade, amount, counter
+	case TypeIncome:
+		m.Type, m.Received, m.Value = eupholio.MovementIncome, amount, counter
+	case TypeFee:
+		m.Type, m.Sent, m.Value = eupholio.MovementFee, amount, counter
+	case TypeDeposit:
+		m.Type, m.Received = eupholio.MovementDeposit, amount
+	case TypeWithdraw:
+		m.Type, m.Sent = eupholio.MovementWithdraw, amount
+	default:
+		return nil, fmt.Errorf("unknown type '%s'", entry.Type)
+	}
+	if m.Type == eupholio.MovementTrade && counter.IsZero() {
+		return nil, fmt.Errorf("%s requires counter currency and quantity", entry.Type)
+	}
+	return m, nil
+}
@@ -0,0 +1,136 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package ledger
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/currency"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+// Translator is a translator for the eupholio ledger format
+type Translator struct {
+	repository   Repository
+	mainCurrency currency.Symbol
+}
+
+// NewTranslator create a translator for the eupholio ledger format
+func NewTranslator(repo Repository, mainCurrency currency.Symbol) *Translator {
+	return &Translator{
+		repository:   repo,
+		mainCurrency: mainCurrency,
+	}
+}
+
+// Translate stores extracted transaction data to transaction table
+func (t *Translator) Translate(ctx context.Context, repo eupholio.Repository, start, end time.Time) error {
+	ledgerRepository := t.repository
+
+	n, err := repo.DeleteTransaction(ctx, WalletCode, start, end)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		log.Println("deleted", n, "entries from events")
+	}
+
+	entries, err := ledgerRepository.FindEntries(ctx, start, end)
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		log.Println("no transction found")
+	}
+
+	var events []*models.Event
+
+	for _, group := range GroupEntries(entries) {
+		transaction, err := repo.CreateTransaction(ctx, group[0].Time, WalletCode, group[0].Account, group[0].ID)
+		if err != nil {
+			return err
+		}
+
+		es, desc, err := t.TranslateTransaction(transaction, group)
+		if err != nil {
+			return err
+		}
+		transaction.Description = desc
+		if err := repo.UpdateTransaction(ctx, transaction); err != nil {
+			return err
+		}
+
+		events = append(events, es...)
+	}
+
+	err = repo.CreateEvents(ctx, events)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GroupEntries groups entries by the account, the wallet and the transaction id in order of appearance
+func GroupEntries(entries models.LedgerEntrySlice) []models.LedgerEntrySlice {
+	var groups []models.LedgerEntrySlice
+	index := make(map[[3]string]int)
+	for _, entry := range entries {
+		key := [3]string{entry.Account, entry.Wallet, entry.Tid}
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], entry)
+	}
+	return groups
+}
+
+// TranslateTransaction translates entries of a transaction to events
+func (t *Translator) TranslateTransaction(transaction *models.Transaction, entries models.LedgerEntrySlice) (models.EventSlice, string, error) {
+	newEvent := eupholio.NewEventFunc(transaction.Time, transaction.ID)
+	var events models.EventSlice
+	var descs []string
+	for _, entry := range entries {
+		m, err := ToMovement(entry)
+		if err != nil {
+			return nil, "", fmt.Errorf("ledger entry %d: %w", entry.ID, err)
+		}
+		es, err := m.Events(t.mainCurrency.String(), newEvent)
+		if err != nil {
+			return nil, "", fmt.Errorf("ledger entry %d: %w", entry.ID, err)
+		}
+		events = append(events, es...)
+		if entry.Description != "" {
+			descs = append(descs, entry.Description)
+		} else {
+			descs = append(descs, m.Description())
+		}
+	}
+	return events, fmt.Sprintf("%s: %s", entries[0].Wallet, strings.Join(descs, "; ")), nil
+}
@@ -0,0 +1,203 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package manifest reads a pipeline manifest, which describes price sources, files to import,
+// cost methods of years and reports of the yearly workflow run by etl run.
+package manifest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v2"
+)
+
+// Price sources
+const (
+	SourceCoingecko          = "coingecko"
+	SourceYahooFinance       = "yahoofinance"
+	SourceCryptoDataDownload = "cryptodatadownload"
+)
+
+// ExchangeAuto imports files detecting their types by headers
+const ExchangeAuto = "auto"
+
+// Manifest describes a pipeline
+type Manifest struct {
+	Portfolio       string         `yaml:"portfolio" toml:"portfolio"`
+	Fiat            string         `yaml:"fiat" toml:"fiat"`         // the fiat of the portfolio if empty
+	Timezone        string         `yaml:"timezone" toml:"timezone"` // time zone of years, the timezone of the portfolio if empty
+	Prices          []*PriceSource `yaml:"prices" toml:"prices"`
+	Imports         []*Import      `yaml:"imports" toml:"imports"`
+	Years           []*Year        `yaml:"years" toml:"years"`
+	VerifyWith      string         `yaml:"verify_with" toml:"verify_with"` // path to eupholio-core-cli
+	VerifyTolerance string         `yaml:"verify_tolerance" toml:"verify_tolerance"`
+	Reports         *Reports       `yaml:"reports" toml:"reports"`
+
+	dir string
+}
+
+// PriceSource is a source of historical prices
+type PriceSource struct {
+	Source   string   `yaml:"source" toml:"source"`
+	Dir      string   `yaml:"dir" toml:"dir"`
+	Download bool     `yaml:"download" toml:"download"` // download files before loading them
+	Files    string   `yaml:"files" toml:"files"`       // glob of files to load (*.csv in dir by default)
+	Fiat     []string `yaml:"fiat" toml:"fiat"`         // fiat currencies downloaded from Yahoo Finance
+	Symbols  []string `yaml:"symbols" toml:"symbols"`   // symbols downloaded from Yahoo Finance
+}
+
+// Import is files of an exchange to import
+type Import struct {
+	Exchange string   `yaml:"exchange" toml:"exchange"` // name of an exchange or auto
+	FileType string   `yaml:"filetype" toml:"filetype"`
+	Files    []string `yaml:"files" toml:"files"` // globs of files
+	Timezone string   `yaml:"timezone" toml:"timezone"`
+	Lenient  bool     `yaml:"lenient" toml:"lenient"`
+	Account  string   `yaml:"account" toml:"account"`
+}
+
+// Year is a year to translate and calculate
+type Year struct {
+	Year   int    `yaml:"year" toml:"year"`
+	Method string `yaml:"method" toml:"method"` // cost method (wam, mam)
+}
+
+// Reports are reports written for each year
+type Reports struct {
+	Dir    string `yaml:"dir" toml:"dir"`
+	Source string `yaml:"source" toml:"source"` // price source of balances
+	Format string `yaml:"format" toml:"format"`
+}
+
+// Load reads a manifest file in YAML or TOML by its extension
+func Load(path string) (*Manifest, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	m, err := Parse(b, strings.TrimPrefix(filepath.Ext(path), "."))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	m.dir = filepath.Dir(path)
+	return m, nil
+}
+
+// Parse parses a manifest in a format (yaml, yml or toml) and fills default values
+func Parse(b []byte, format string) (*Manifest, error) {
+	m := &Manifest{}
+	switch strings.ToLower(format) {
+	case "yaml", "yml":
+		if err := yaml.UnmarshalStrict(b, m); err != nil {
+			return nil, err
+		}
+	case "toml":
+		md, err := toml.Decode(string(b), m)
+		if err != nil {
+			return nil, err
+		}
+		if undecoded := md.Undecoded(); len(undecoded) > 0 {
+			return nil, fmt.Errorf("unknown key %s", undecoded[0])
+		}
+	default:
+		return nil, fmt.Errorf("unknown manifest format %s", format)
+	}
+	if err := m.init(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *Manifest) init() error {
+	if m.VerifyTolerance == "" {
+		m.VerifyTolerance = "1"
+	}
+	for _, p := range m.Prices {
+		switch p.Source {
+		case SourceCoingecko, SourceYahooFinance, SourceCryptoDataDownload:
+		default:
+			return fmt.Errorf("unknown price source %s", p.Source)
+		}
+		if p.Dir == "" {
+			p.Dir = filepath.Join("pricedata", p.Source)
+		}
+		if p.Files == "" {
+			p.Files = filepath.Join(p.Dir, "*.csv")
+		}
+	}
+	for _, i := range m.Imports {
+		if i.Exchange == "" {
+			return fmt.Errorf("exchange of imports is required")
+		}
+		if len(i.Files) == 0 {
+			return fmt.Errorf("files of %s are required", i.Exchange)
+		}
+		if i.Timezone == "" {
+			i.Timezone = "UTC"
+		}
+	}
+	for _, y := range m.Years {
+		if y.Year == 0 {
+			return fmt.Errorf("year is required")
+		}
+	}
+	sort.Slice(m.Years, func(i, j int) bool { return m.Years[i].Year < m.Years[j].Year })
+	if m.Reports != nil {
+		if len(m.Years) == 0 {
+			return fmt.Errorf("years are required to write reports")
+		}
+		if m.Reports.Dir == "" {
+			m.Reports.Dir = "reports"
+		}
+		if m.Reports.Source == "" {
+			m.Reports.Source = SourceYahooFinance
+		}
+		if m.Reports.Format == "" {
+			m.Reports.Format = "table"
+		}
+	}
+	return nil
+}
+
+// Path resolves a path relative to the directory of the manifest
+func (m *Manifest) Path(path string) string {
+	if filepath.IsAbs(path) || m.dir == "" {
+		return path
+	}
+	return filepath.Join(m.dir, path)
+}
+
+// Glob returns files matching patterns relative to the directory of the manifest
+func (m *Manifest) Glob(patterns ...string) ([]string, error) {
+	var files []string
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(m.Path(pattern))
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, matches...)
+	}
+	return files, nil
+}
@@ -0,0 +1,99 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package manifest
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+const yamlManifest = `
+fiat: JPY
+prices:
+  - source: yahoofinance
+    download: true
+imports:
+  - exchange: bitflyer
+    files: [bitflyer/*.csv]
+    timezone: Asia/Tokyo
+years:
+  - year: 2021
+    method: mam
+  - year: 2020
+    method: wam
+reports:
+  dir: out
+`
+
+const tomlManifest = `
+fiat = "JPY"
+
+[[prices]]
+source = "yahoofinance"
+download = true
+
+[[imports]]
+exchange = "bitflyer"
+files = ["bitflyer/*.csv"]
+timezone = "Asia/Tokyo"
+
+[[years]]
+year = 2021
+method = "mam"
+
+[[years]]
+year = 2020
+method = "wam"
+
+[reports]
+dir = "out"
+`
+
+func TestParse(t *testing.T) {
+	for format, src := range map[string]string{"yaml": yamlManifest, "toml": tomlManifest} {
+		m, err := Parse([]byte(src), format)
+		if err != nil {
+			t.Fatalf("%s: %v", format, err)
+		}
+		if m.VerifyTolerance != "1" || m.Prices[0].Dir != filepath.Join("pricedata", "yahoofinance") {
+			t.Errorf("%s: defaults are not filled %+v", format, m)
+		}
+		if m.Years[0].Year != 2020 || m.Years[1].Method != "mam" {
+			t.Errorf("%s: years must be sorted", format)
+		}
+		if m.Imports[0].Files[0] != "bitflyer/*.csv" || m.Reports.Source != SourceYahooFinance {
+			t.Errorf("%s: unexpected manifest %+v", format, m)
+		}
+	}
+}
+
+func TestParseError(t *testing.T) {
+	for _, src := range []string{
+		"prices:\n  - source: unknown\n",
+		"imports:\n  - exchange: bitflyer\n",
+		"unknown: 1\n",
+		"reports:\n  dir: out\n",
+	} {
+		if _, err := Parse([]byte(src), "yaml"); err == nil {
+			t.Errorf("error expected for %q", src)
+		}
+	}
+}
@@ -0,0 +1,502 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package migration
+
+// baselineMySQL is the schema of the former resources/master.sql and resources/schema.sql before migrations were introduced.
+// Its tables are created unless they exist, so that databases created by those files are adopted as version 1
+// without losing their rows, and the following migrations alter them to the latest version.
+const baselineMySQL = `
+/* Master Data Tables */
+
+CREATE TABLE IF NOT EXISTS symbols (
+    symbol CHAR(10) PRIMARY KEY,
+    "name" VARCHAR(255) NOT NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS market_price (
+    source VARCHAR(20),
+    currency CHAR(10) NOT NULL,
+    "time" DATETIME NOT NULL,
+    base_currency CHAR(10) NOT NULL,
+    price DECIMAL(20, 10) NOT NULL,
+    PRIMARY KEY (source, base_currency, currency, "time")
+);
+
+/* Configuration Tables */
+
+CREATE TABLE IF NOT EXISTS config (
+    id INT NOT NULL,
+    year INT NOT NULL,
+    cost_method VARCHAR(20) NOT NULL,
+    PRIMARY KEY (id, year)
+);
+
+/* Transaction Tables */
+
+CREATE TABLE IF NOT EXISTS transactions (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    "time" DATETIME NOT NULL,
+    wallet_code VARCHAR(10) NOT NULL,
+    wallet_tid INT NOT NULL,
+    "description" VARCHAR(255) NOT NULL,
+    INDEX("time")
+);
+
+CREATE TABLE IF NOT EXISTS "event" (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    transaction_id INT NOT NULL,
+    "time" DATETIME NOT NULL,
+    "type" CHAR(10) NOT NULL,
+    currency VARCHAR(10) NOT NULL,
+    quantity DECIMAL(20, 10) NOT NULL,
+    base_currency VARCHAR(10) NOT NULL,
+    base_quantity DECIMAL(20, 10) NOT NULL,
+    INDEX (time),
+    INDEX (currency, time),
+    INDEX (transaction_id)
+);
+
+CREATE TABLE IF NOT EXISTS "entry" (
+    id INT PRIMARY KEY,
+    transaction_id INT NOT NULL,
+    "time" DATETIME NOT NULL,
+    "type" CHAR(10) NOT NULL,
+    currency VARCHAR(10) NOT NULL,
+    quantity DECIMAL(20, 10) NOT NULL,
+    position DECIMAL(20, 10) NOT NULL,
+    fiat_currency VARCHAR(10) NOT NULL,
+    fiat_quantity DECIMAL(20, 10) NOT NULL,
+    commission DECIMAL(20, 10) DEFAULT NULL,
+    price DECIMAL(20, 10) DEFAULT NULL,
+    INDEX (time),
+    INDEX (currency, time),
+    INDEX (transaction_id)
+);
+
+CREATE TABLE IF NOT EXISTS balance (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    year INT NOT NULL,
+    currency VARCHAR(10) NOT NULL,
+    beginning_quantity DECIMAL(20, 10) NOT NULL,
+    open_quantity DECIMAL(20, 10) NOT NULL,
+    close_quantity DECIMAL(20, 10) NOT NULL,
+    price DECIMAL(20, 10) NOT NULL,
+    quantity DECIMAL(20, 10) NOT NULL,
+    profit DECIMAL(20, 10) NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS method (
+    year INT PRIMARY KEY,
+    method CHAR(10) NOT NULL
+);
+
+/* Wallet Tables */
+
+/* Bitflyer */
+
+CREATE TABLE IF NOT EXISTS bf_transactions (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    tr_date DATETIME NOT NULL,
+    currency VARCHAR(10) NOT NULL,
+    tr_type INT(1) NOT NULL,
+    tr_price DECIMAL(20, 10) NOT NULL,
+    currency1 VARCHAR(10) NOT NULL,
+    currency1_quantity DECIMAL(20, 10) NOT NULL,
+    fee DECIMAL(20, 10) NOT NULL,
+    currency1_jpy_rate DECIMAL(20, 10),
+    currency2 VARCHAR(10),
+    currency2_quantity DECIMAL(20, 10) NOT NULL,
+    deal_type INT(1),
+    order_id VARCHAR(100) NOT NULL,
+    remarks VARCHAR(255)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS bf_orders (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    order_id VARCHAR(100) NOT NULL,
+    transaction_id INT NOT NULL,
+    tr_date DATETIME NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS coincheck_history (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    id_code VARCHAR(16) NOT NULL,
+    "time" DATETIME NOT NULL,
+    operation VARCHAR(30) NOT NULL,
+    amount DECIMAL(20, 10) NOT NULL,
+    trading_currency VARCHAR(10) NOT NULL,
+    price DECIMAL(20, 10),
+    original_currency VARCHAR(10),
+    fee DECIMAL(20, 10),
+    comment VARCHAR(255) NOT NULL,
+    UNIQUE(id_code),
+    INDEX("time")
+);
+
+/* Bittrex */
+
+CREATE TABLE IF NOT EXISTS bittrex_order_history (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    uuid CHAR(36) NOT NULL,
+    exchange VARCHAR(20) NOT NULL,
+    "timestamp" DATETIME NOT NULL,
+    order_type INT NOT NULL,
+    "limit" DECIMAL(20, 10) NOT NULL,
+    quantity DECIMAL(20, 10) NOT NULL,
+    quantity_remaining DECIMAL(20, 10) NOT NULL,
+    commission DECIMAL(20, 10) NOT NULL,
+    price DECIMAL(20, 10) NOT NULL,
+    price_per_unit DECIMAL(20, 10) NOT NULL,
+    is_conditional TINYINT(1) NOT NULL,
+    "condition" VARCHAR(100),
+    condition_target DECIMAL(20, 10),
+    immediate_or_cancel TINYINT(1) NOT NULL,
+    "closed" DATETIME NOT NULL,
+    time_in_force_type_id INT NOT NULL,
+    time_in_force TEXT,
+    INDEX (uuid),
+    INDEX ("timestamp")
+);
+
+CREATE TABLE IF NOT EXISTS bittrex_deposit_history (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    "timestamp" DATETIME NOT NULL,
+    currency VARCHAR(10) NOT NULL,
+    quantity DECIMAL(20, 10) NOT NULL,
+    "status" VARCHAR(10) NOT NULL,
+    INDEX ("timestamp")
+);
+
+CREATE TABLE IF NOT EXISTS bittrex_withdraw_history (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    "timestamp" DATETIME NOT NULL,
+    currency VARCHAR(10) NOT NULL,
+    quantity DECIMAL(20, 10) NOT NULL,
+    "status" VARCHAR(10) NOT NULL,
+    INDEX ("timestamp")
+);
+
+/* Poloniex */
+
+CREATE TABLE IF NOT EXISTS poloniex_trades (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    "date" DATETIME NOT NULL,
+    market VARCHAR(20) NOT NULL,
+    "type" VARCHAR(10) NOT NULL,
+    price DECIMAL(20, 10) NOT NULL,
+    amount DECIMAL(20, 10) NOT NULL,
+    total DECIMAL(20, 10) NOT NULL,
+    fee VARCHAR(20) NOT NULL,
+    order_number BIGINT NOT NULL,
+    base_total_less_fee DECIMAL(20, 10) NOT NULL,
+    quote_total_less_fee DECIMAL(20, 10) NOT NULL,
+    fee_currency VARCHAR(10) NOT NULL,
+    fee_total DECIMAL(20, 10) NOT NULL,
+    INDEX ("date")
+);
+
+CREATE TABLE IF NOT EXISTS poloniex_deposits (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    "date" DATETIME NOT NULL,
+    currency VARCHAR(10) NOT NULL,
+    amount DECIMAL(20, 10) NOT NULL,
+    "address" VARCHAR(100) NOT NULL,
+    "status" VARCHAR(100) NOT NULL,
+    INDEX ("date")
+);
+
+CREATE TABLE IF NOT EXISTS poloniex_withdrawals (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    "date" DATETIME NOT NULL,
+    currency VARCHAR(10) NOT NULL,
+    amount DECIMAL(20, 10) NOT NULL,
+    fee_deducted DECIMAL(20, 10) NOT NULL,
+    amount_minus_fee DECIMAL(20, 10) NOT NULL,
+    "address" VARCHAR(100) NOT NULL,
+    "status" VARCHAR(100) NOT NULL,
+    INDEX ("date")
+);
+
+CREATE TABLE IF NOT EXISTS poloniex_distributions (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    "date" DATETIME NOT NULL,
+    currency VARCHAR(10) NOT NULL,
+    amount DECIMAL(20, 10) NOT NULL,
+    wallet VARCHAR(100) NOT NULL,
+    INDEX ("date")
+);
+
+/* Cryptact */
+
+CREATE TABLE IF NOT EXISTS cryptact_custom (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    "timestamp" DATETIME NOT NULL,
+    "action" VARCHAR(20) NOT NULL,
+    "source" VARCHAR(100) NOT NULL,
+    base VARCHAR(10) NOT NULL,
+    volume DECIMAL(20, 10) NOT NULL,
+    price DECIMAL(20, 10),
+    "counter" VARCHAR(10) NOT NULL,
+    fee DECIMAL(20, 10) NOT NULL,
+    fee_ccy VARCHAR(10) NOT NULL,
+    INDEX ("timestamp")
+);
+`
+
+// baselineSQLite is baselineMySQL for SQLite.
+// Decimals are stored as TEXT so that they are not rounded to REAL.
+const baselineSQLite = `
+/* Master Data Tables */
+
+CREATE TABLE IF NOT EXISTS symbols (
+    symbol CHAR(10) PRIMARY KEY,
+    "name" VARCHAR(255) NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS market_price (
+    source VARCHAR(20),
+    currency CHAR(10) NOT NULL,
+    "time" DATETIME NOT NULL,
+    base_currency CHAR(10) NOT NULL,
+    price TEXT NOT NULL,
+    PRIMARY KEY (source, base_currency, currency, "time")
+);
+
+/* Configuration Tables */
+
+CREATE TABLE IF NOT EXISTS config (
+    id INT NOT NULL,
+    year INT NOT NULL,
+    cost_method VARCHAR(20) NOT NULL,
+    PRIMARY KEY (id, year)
+);
+
+/* Transaction Tables */
+
+CREATE TABLE IF NOT EXISTS transactions (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    "time" DATETIME NOT NULL,
+    wallet_code VARCHAR(10) NOT NULL,
+    wallet_tid INT NOT NULL,
+    "description" VARCHAR(255) NOT NULL
+);
+CREATE INDEX IF NOT EXISTS transactions_time ON transactions ("time");
+
+CREATE TABLE IF NOT EXISTS "event" (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    transaction_id INT NOT NULL,
+    "time" DATETIME NOT NULL,
+    "type" CHAR(10) NOT NULL,
+    currency VARCHAR(10) NOT NULL,
+    quantity TEXT NOT NULL,
+    base_currency VARCHAR(10) NOT NULL,
+    base_quantity TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS event_time ON "event" (time);
+CREATE INDEX IF NOT EXISTS event_currency_time ON "event" (currency, time);
+CREATE INDEX IF NOT EXISTS event_transaction_id ON "event" (transaction_id);
+
+CREATE TABLE IF NOT EXISTS "entry" (
+    id INT PRIMARY KEY,
+    transaction_id INT NOT NULL,
+    "time" DATETIME NOT NULL,
+    "type" CHAR(10) NOT NULL,
+    currency VARCHAR(10) NOT NULL,
+    quantity TEXT NOT NULL,
+    position TEXT NOT NULL,
+    fiat_currency VARCHAR(10) NOT NULL,
+    fiat_quantity TEXT NOT NULL,
+    commission TEXT DEFAULT NULL,
+    price TEXT DEFAULT NULL
+);
+CREATE INDEX IF NOT EXISTS entry_time ON "entry" (time);
+CREATE INDEX IF NOT EXISTS entry_currency_time ON "entry" (currency, time);
+CREATE INDEX IF NOT EXISTS entry_transaction_id ON "entry" (transaction_id);
+
+CREATE TABLE IF NOT EXISTS balance (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    year INT NOT NULL,
+    currency VARCHAR(10) NOT NULL,
+    beginning_quantity TEXT NOT NULL,
+    open_quantity TEXT NOT NULL,
+    close_quantity TEXT NOT NULL,
+    price TEXT NOT NULL,
+    quantity TEXT NOT NULL,
+    profit TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS method (
+    year INT PRIMARY KEY,
+    method CHAR(10) NOT NULL
+);
+
+/* Wallet Tables */
+
+/* Bitflyer */
+
+CREATE TABLE IF NOT EXISTS bf_transactions (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    tr_date DATETIME NOT NULL,
+    currency VARCHAR(10) NOT NULL,
+    tr_type INT(1) NOT NULL,
+    tr_price TEXT NOT NULL,
+    currency1 VARCHAR(10) NOT NULL,
+    currency1_quantity TEXT NOT NULL,
+    fee TEXT NOT NULL,
+    currency1_jpy_rate TEXT,
+    currency2 VARCHAR(10),
+    currency2_quantity TEXT NOT NULL,
+    deal_type INT(1),
+    order_id VARCHAR(100) NOT NULL,
+    remarks VARCHAR(255)
+);
+
+CREATE TABLE IF NOT EXISTS bf_orders (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    order_id VARCHAR(100) NOT NULL,
+    transaction_id INT NOT NULL,
+    tr_date DATETIME NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS coincheck_history (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    id_code VARCHAR(16) NOT NULL,
+    "time" DATETIME NOT NULL,
+    operation VARCHAR(30) NOT NULL,
+    amount TEXT NOT NULL,
+    trading_currency VARCHAR(10) NOT NULL,
+    price TEXT,
+    original_currency VARCHAR(10),
+    fee TEXT,
+    comment VARCHAR(255) NOT NULL,
+    UNIQUE(id_code)
+);
+CREATE INDEX IF NOT EXISTS coincheck_history_time ON coincheck_history ("time");
+
+/* Bittrex */
+
+CREATE TABLE IF NOT EXISTS bittrex_order_history (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    uuid CHAR(36) NOT NULL,
+    exchange VARCHAR(20) NOT NULL,
+    "timestamp" DATETIME NOT NULL,
+    order_type INT NOT NULL,
+    "limit" TEXT NOT NULL,
+    quantity TEXT NOT NULL,
+    quantity_remaining TEXT NOT NULL,
+    commission TEXT NOT NULL,
+    price TEXT NOT NULL,
+    price_per_unit TEXT NOT NULL,
+    is_conditional TINYINT(1) NOT NULL,
+    "condition" VARCHAR(100),
+    condition_target TEXT,
+    immediate_or_cancel TINYINT(1) NOT NULL,
+    "closed" DATETIME NOT NULL,
+    time_in_force_type_id INT NOT NULL,
+    time_in_force TEXT
+);
+CREATE INDEX IF NOT EXISTS bittrex_order_history_uuid ON bittrex_order_history (uuid);
+CREATE INDEX IF NOT EXISTS bittrex_order_history_timestamp ON bittrex_order_history ("timestamp");
+
+CREATE TABLE IF NOT EXISTS bittrex_deposit_history (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    "timestamp" DATETIME NOT NULL,
+    currency VARCHAR(10) NOT NULL,
+    quantity TEXT NOT NULL,
+    "status" VARCHAR(10) NOT NULL
+);
+CREATE INDEX IF NOT EXISTS bittrex_deposit_history_timestamp ON bittrex_deposit_history ("timestamp");
+
+CREATE TABLE IF NOT EXISTS bittrex_withdraw_history (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    "timestamp" DATETIME NOT NULL,
+    currency VARCHAR(10) NOT NULL,
+    quantity TEXT NOT NULL,
+    "status" VARCHAR(10) NOT NULL
+);
+CREATE INDEX IF NOT EXISTS bittrex_withdraw_history_timestamp ON bittrex_withdraw_history ("timestamp");
+
+/* Poloniex */
+
+CREATE TABLE IF NOT EXISTS poloniex_trades (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    "date" DATETIME NOT NULL,
+    market VARCHAR(20) NOT NULL,
+    "type" VARCHAR(10) NOT NULL,
+    price TEXT NOT NULL,
+    amount TEXT NOT NULL,
+    total TEXT NOT NULL,
+    fee VARCHAR(20) NOT NULL,
+    order_number BIGINT NOT NULL,
+    base_total_less_fee TEXT NOT NULL,
+    quote_total_less_fee TEXT NOT NULL,
+    fee_currency VARCHAR(10) NOT NULL,
+    fee_total TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS poloniex_trades_date ON poloniex_trades ("date");
+
+CREATE TABLE IF NOT EXISTS poloniex_deposits (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    "date" DATETIME NOT NULL,
+    currency VARCHAR(10) NOT NULL,
+    amount TEXT NOT NULL,
+    "address" VARCHAR(100) NOT NULL,
+    "status" VARCHAR(100) NOT NULL
+);
+CREATE INDEX IF NOT EXISTS poloniex_deposits_date ON poloniex_deposits ("date");
+
+CREATE TABLE IF NOT EXISTS poloniex_withdrawals (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    "date" DATETIME NOT NULL,
+    currency VARCHAR(10) NOT NULL,
+    amount TEXT NOT NULL,
+    fee_deducted TEXT NOT NULL,
+    amount_minus_fee TEXT NOT NULL,
+    "address" VARCHAR(100) NOT NULL,
+    "status" VARCHAR(100) NOT NULL
+);
+CREATE INDEX IF NOT EXISTS poloniex_withdrawals_date ON poloniex_withdrawals ("date");
+
+CREATE TABLE IF NOT EXISTS poloniex_distributions (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    "date" DATETIME NOT NULL,
+    currency VARCHAR(10) NOT NULL,
+    amount TEXT NOT NULL,
+    wallet VARCHAR(100) NOT NULL
+);
+CREATE INDEX IF NOT EXISTS poloniex_distributions_date ON poloniex_distributions ("date");
+
+/* Cryptact */
+
+CREATE TABLE IF NOT EXISTS cryptact_custom (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    "timestamp" DATETIME NOT NULL,
+    "action" VARCHAR(20) NOT NULL,
+    "source" VARCHAR(100) NOT NULL,
+    base VARCHAR(10) NOT NULL,
+    volume TEXT NOT NULL,
+    price TEXT,
+    "counter" VARCHAR(10) NOT NULL,
+    fee TEXT NOT NULL,
+    fee_ccy VARCHAR(10) NOT NULL
+);
+CREATE INDEX IF NOT EXISTS cryptact_custom_timestamp ON cryptact_custom ("timestamp");
+`
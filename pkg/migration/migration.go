@@ -0,0 +1,209 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package migration evolves the schema of a database by versioned migrations.
+// Applied migrations are recorded in schema_migrations, so rows of existing tables survive upgrades.
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Dialect is a dialect of SQL of a database driver
+type Dialect string
+
+// Dialects of the database drivers
+const (
+	MySQL  Dialect = "mysql"
+	SQLite Dialect = "sqlite3"
+)
+
+// Migration changes the schema from the previous version to its version.
+// Statements are separated by semicolons at the ends of lines, and identifiers are quoted with double quotes.
+// A migration whose Down is empty cannot be rolled back, e.g. one which adds columns in SQLite which cannot drop them.
+type Migration struct {
+	Version int
+	Name    string
+	Up      map[Dialect]string
+	Down    map[Dialect]string
+}
+
+// Latest returns the version of the last migration
+func Latest() int {
+	return Migrations[len(Migrations)-1].Version
+}
+
+const versionTable = `CREATE TABLE IF NOT EXISTS schema_migrations (
+    version INT PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    applied_at DATETIME NOT NULL
+)`
+
+// State is a migration and the time it was applied at, which is zero if it is pending
+type State struct {
+	*Migration
+	AppliedAt time.Time
+}
+
+// Applied returns whether the migration is applied
+func (s *State) Applied() bool {
+	return !s.AppliedAt.IsZero()
+}
+
+// Initialized returns whether the version table exists in the database
+func Initialized(ctx context.Context, db *sql.DB, dialect Dialect) (bool, error) {
+	var q string
+	switch dialect {
+	case MySQL:
+		q = "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = 'schema_migrations'"
+	case SQLite:
+		q = "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'schema_migrations'"
+	default:
+		return false, fmt.Errorf("unknown dialect %s", dialect)
+	}
+	var n int
+	if err := db.QueryRowContext(ctx, q).Scan(&n); err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// Status returns the states of all migrations in the order of their versions
+func Status(ctx context.Context, db *sql.DB) ([]*State, error) {
+	if _, err := db.ExecContext(ctx, versionTable); err != nil {
+		return nil, err
+	}
+	rows, err := db.QueryContext(ctx, "SELECT version, applied_at FROM schema_migrations")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	applied := make(map[int]time.Time)
+	for rows.Next() {
+		var version int
+		var appliedAt time.Time
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, err
+		}
+		applied[version] = appliedAt
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	states := make([]*State, 0, len(Migrations))
+	for _, m := range Migrations {
+		states = append(states, &State{Migration: m, AppliedAt: applied[m.Version]})
+	}
+	return states, nil
+}
+
+// Migrate applies pending migrations up to the version, or all of them if version is 0, and returns the applied migrations
+func Migrate(ctx context.Context, db *sql.DB, dialect Dialect, version int) ([]*Migration, error) {
+	states, err := Status(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	var applied []*Migration
+	for _, s := range states {
+		if s.Applied() {
+			continue
+		}
+		if version > 0 && s.Version > version {
+			break
+		}
+		if err := run(ctx, db, dialect, s.Migration, s.Up, func(tx *sql.Tx) error {
+			_, err := tx.ExecContext(ctx, "INSERT INTO schema_migrations (version, name, applied_at) VALUES (?, ?, ?)", s.Version, s.Name, time.Now().UTC())
+			return err
+		}); err != nil {
+			return applied, err
+		}
+		applied = append(applied, s.Migration)
+	}
+	return applied, nil
+}
+
+// Rollback rolls back the last applied migrations as many as steps, and returns the rolled back migrations
+func Rollback(ctx context.Context, db *sql.DB, dialect Dialect, steps int) ([]*Migration, error) {
+	states, err := Status(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	var rolledBack []*Migration
+	for i := len(states) - 1; i >= 0 && len(rolledBack) < steps; i-- {
+		s := states[i]
+		if !s.Applied() {
+			continue
+		}
+		if s.Down[dialect] == "" {
+			return rolledBack, fmt.Errorf("migration %d (%s) cannot be rolled back", s.Version, s.Name)
+		}
+		if err := run(ctx, db, dialect, s.Migration, s.Down, func(tx *sql.Tx) error {
+			_, err := tx.ExecContext(ctx, "DELETE FROM schema_migrations WHERE version = ?", s.Version)
+			return err
+		}); err != nil {
+			return rolledBack, err
+		}
+		rolledBack = append(rolledBack, s.Migration)
+	}
+	return rolledBack, nil
+}
+
+// run executes statements of a migration and records it in a transaction.
+// MySQL commits DDL statements implicitly, so a failed migration may be left partially applied there.
+func run(ctx context.Context, db *sql.DB, dialect Dialect, m *Migration, statements map[Dialect]string, record func(tx *sql.Tx) error) error {
+	s, ok := statements[dialect]
+	if !ok {
+		return fmt.Errorf("migration %d (%s) has no statements for %s", m.Version, m.Name, dialect)
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for _, stmt := range split(s, dialect) {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("migration %d (%s): %w", m.Version, m.Name, err)
+		}
+	}
+	if err := record(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// split splits statements, which MySQL executes one by one, and quotes identifiers with backticks for MySQL
+func split(s string, dialect Dialect) []string {
+	if dialect == MySQL {
+		s = strings.Replace(s, `"`, "`", -1)
+	}
+	var statements []string
+	for _, stmt := range strings.Split(s, ";\n") {
+		stmt = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(stmt), ";"))
+		if stmt != "" {
+			statements = append(statements, stmt)
+		}
+	}
+	return statements
+}
@@ -0,0 +1,147 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package migration_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/migration"
+	"github.com/eupholio/eupholio/pkg/sqlite"
+)
+
+func TestMigrateAndRollback(t *testing.T) {
+	ctx := context.Background()
+	db, err := sqlite.Open(":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if _, err := db.Exec("INSERT INTO portfolios (id, name, fiat, timezone) VALUES (1, 'corp', 'JPY', 'Asia/Tokyo')"); err != nil {
+		t.Fatal(err)
+	}
+
+	pending := func() int {
+		states, err := migration.Status(ctx, db)
+		if err != nil {
+			t.Fatal(err)
+		}
+		n := 0
+		for _, s := range states {
+			if !s.Applied() {
+				n++
+			}
+		}
+		return n
+	}
+	if n := pending(); n != 0 {
+		t.Fatalf("a new database should be migrated to the latest version but %d migrations are pending", n)
+	}
+
+	rolledBack, err := migration.Rollback(ctx, db, migration.SQLite, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rolledBack) != 1 || rolledBack[0].Version != migration.Latest() || pending() != 1 {
+		t.Fatalf("unexpected rollback %v", rolledBack)
+	}
+	// SQLite cannot drop columns, so rollbacks stop at a migration which adds them before the baseline
+	rolledBack, err = migration.Rollback(ctx, db, migration.SQLite, migration.Latest())
+	if err == nil {
+		t.Error("the baseline should not be rolled back")
+	}
+
+	applied, err := migration.Migrate(ctx, db, migration.SQLite, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(applied) != len(rolledBack)+1 || pending() != 0 {
+		t.Fatalf("unexpected migrations %v", applied)
+	}
+
+	var name string
+	if err := db.QueryRow("SELECT name FROM portfolios WHERE id = 1").Scan(&name); err != nil || name != "corp" {
+		t.Errorf("rows should survive migrations: %s %v", name, err)
+	}
+}
+
+func TestUpgradeBaseline(t *testing.T) {
+	ctx := context.Background()
+	db, err := sql.Open(sqlite.DriverName, ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	// a database created by the schema before migrations
+	if _, err := migration.Migrate(ctx, db, migration.SQLite, 1); err != nil {
+		t.Fatal(err)
+	}
+	for _, stmt := range []string{
+		"INSERT INTO config (id, year, cost_method) VALUES (0, 2019, 'mam')",
+		"INSERT INTO transactions (time, wallet_code, wallet_tid, description) VALUES ('2019-04-01 01:00:00', 'BF', 1, 'buy')",
+		"INSERT INTO event (transaction_id, time, type, currency, quantity, base_currency, base_quantity) VALUES (1, '2019-04-01 01:00:00', 'BUY', 'BTC', '1', 'JPY', '500000')",
+		"INSERT INTO entry (id, transaction_id, time, type, currency, quantity, position, fiat_currency, fiat_quantity) VALUES (1, 1, '2019-04-01 01:00:00', 'OPEN', 'BTC', '1', '1', 'JPY', '500000')",
+		"INSERT INTO balance (year, currency, beginning_quantity, open_quantity, close_quantity, price, quantity, profit) VALUES (2019, 'BTC', '0', '1', '0', '500000', '1', '0')",
+		"INSERT INTO bf_transactions (tr_date, currency, tr_type, tr_price, currency1, currency1_quantity, fee, currency2_quantity, order_id) VALUES ('2019-04-01 01:00:00', 'BTC/JPY', 1, '500000', 'BTC', '1', '0', '-500000', 'JOR1')",
+		"INSERT INTO poloniex_trades (date, market, type, price, amount, total, fee, order_number, base_total_less_fee, quote_total_less_fee, fee_currency, fee_total) VALUES ('2019-04-01 01:00:00', 'ETH/BTC', 'Buy', '0.03', '1', '0.03', '0.1%', 1001, '-0.03', '0.999', 'ETH', '0.001')",
+	} {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	applied, err := migration.Migrate(ctx, db, migration.SQLite, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(applied) != migration.Latest()-1 {
+		t.Fatalf("unexpected migrations %v", applied)
+	}
+
+	config, err := models.FindConfig(ctx, db, 0, 2019)
+	if err != nil || config.CostMethod != "mam" {
+		t.Errorf("the config should belong to the default portfolio: %v %v", config, err)
+	}
+	transaction, err := models.Transactions().One(ctx, db)
+	if err != nil || transaction.Description != "buy" || transaction.PortfolioID != 0 || transaction.Account != "" {
+		t.Errorf("unexpected transaction %v %v", transaction, err)
+	}
+	for name, count := range map[string]func() (int64, error){
+		"event":   func() (int64, error) { return models.Events(models.EventWhere.PortfolioID.EQ(0)).Count(ctx, db) },
+		"entry":   func() (int64, error) { return models.Entries(models.EntryWhere.PortfolioID.EQ(0)).Count(ctx, db) },
+		"balance": func() (int64, error) { return models.Balances(models.BalanceWhere.PortfolioID.EQ(0)).Count(ctx, db) },
+		"bf_transactions": func() (int64, error) {
+			return models.BFTransactions(models.BFTransactionWhere.PortfolioID.EQ(0)).Count(ctx, db)
+		},
+	} {
+		if n, err := count(); err != nil || n != 1 {
+			t.Errorf("%d rows of %s survived: %v", n, name, err)
+		}
+	}
+	trade, err := models.PoloniexTrades().One(ctx, db)
+	if err != nil || trade.OrderNumber != 1001 || trade.Category != "Exchange" || trade.RowKey.Valid {
+		t.Errorf("unexpected trade %v %v", trade, err)
+	}
+}
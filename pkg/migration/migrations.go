@@ -0,0 +1,606 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package migration
+
+import (
+	"fmt"
+	"strings"
+)
+
+// historyTables are the tables of histories of exchanges in the baseline
+var historyTables = []string{
+	"bf_transactions",
+	"coincheck_history",
+	"bittrex_order_history",
+	"bittrex_deposit_history",
+	"bittrex_withdraw_history",
+	"poloniex_trades",
+	"poloniex_deposits",
+	"poloniex_withdrawals",
+	"poloniex_distributions",
+	"cryptact_custom",
+}
+
+// Migrations are the migrations in the order of their versions. New migrations are appended to the end.
+var Migrations = []*Migration{
+	{
+		Version: 1,
+		Name:    "baseline",
+		Up: map[Dialect]string{
+			MySQL:  baselineMySQL,
+			SQLite: baselineSQLite,
+		},
+	},
+	{
+		Version: 2,
+		Name:    "add columns of exchange histories",
+		Up: both(`ALTER TABLE bf_transactions ADD COLUMN source_id VARCHAR(100);
+ALTER TABLE coincheck_history ADD COLUMN pair VARCHAR(20);
+ALTER TABLE poloniex_trades ADD COLUMN category VARCHAR(20) NOT NULL DEFAULT 'Exchange';`),
+		Down: map[Dialect]string{
+			MySQL: `ALTER TABLE bf_transactions DROP COLUMN source_id;
+ALTER TABLE coincheck_history DROP COLUMN pair;
+ALTER TABLE poloniex_trades DROP COLUMN category;`,
+		},
+	},
+	{
+		Version: 3,
+		Name:    "add accounts",
+		Up: both(`ALTER TABLE transactions ADD COLUMN account VARCHAR(50) NOT NULL DEFAULT '';
+` + forEach(historyTables, `ALTER TABLE %[1]s ADD COLUMN account VARCHAR(50) NOT NULL DEFAULT '';`)),
+		Down: map[Dialect]string{
+			MySQL: `ALTER TABLE transactions DROP COLUMN account;
+` + forEach(historyTables, `ALTER TABLE %[1]s DROP COLUMN account;`),
+		},
+	},
+	{
+		Version: 4,
+		Name:    "add portfolios",
+		Up: map[Dialect]string{
+			MySQL: portfoliosUp + `
+DROP INDEX "time" ON "event";
+DROP INDEX "time" ON "entry";`,
+			SQLite: portfoliosUp + `
+DROP INDEX event_time;
+DROP INDEX entry_time;`,
+		},
+		Down: map[Dialect]string{
+			MySQL: `CREATE INDEX "time" ON "event" ("time");
+CREATE INDEX "time" ON "entry" ("time");
+DROP INDEX event_portfolio_id_time ON "event";
+DROP INDEX entry_portfolio_id_time ON "entry";
+DROP INDEX balance_portfolio_id_year ON balance;
+DROP INDEX bf_transactions_portfolio_id_source_id ON bf_transactions;
+ALTER TABLE transactions DROP COLUMN portfolio_id;
+ALTER TABLE "event" DROP COLUMN portfolio_id;
+ALTER TABLE "entry" DROP COLUMN portfolio_id;
+ALTER TABLE balance DROP COLUMN portfolio_id;
+` + forEach(historyTables, `ALTER TABLE %[1]s DROP COLUMN portfolio_id;`) + `
+DROP TABLE portfolios;`,
+		},
+	},
+	{
+		Version: 5,
+		Name:    "key config by portfolio",
+		Up: map[Dialect]string{
+			MySQL: `ALTER TABLE config ADD COLUMN portfolio_id INT NOT NULL DEFAULT 0 AFTER id;
+UPDATE config SET portfolio_id = id;
+ALTER TABLE config DROP PRIMARY KEY, ADD PRIMARY KEY (portfolio_id, year);
+ALTER TABLE config ALTER COLUMN id SET DEFAULT 0;
+UPDATE config SET id = 0;`,
+			SQLite: `CREATE TABLE config_by_portfolio (
+    id INT NOT NULL DEFAULT 0,
+    portfolio_id INT NOT NULL DEFAULT 0,
+    year INT NOT NULL,
+    cost_method VARCHAR(20) NOT NULL,
+    PRIMARY KEY (portfolio_id, year)
+);
+INSERT INTO config_by_portfolio (portfolio_id, year, cost_method) SELECT id, year, cost_method FROM config;
+DROP TABLE config;
+ALTER TABLE config_by_portfolio RENAME TO config;`,
+		},
+		Down: map[Dialect]string{
+			MySQL: `UPDATE config SET id = portfolio_id;
+ALTER TABLE config DROP PRIMARY KEY, ADD PRIMARY KEY (id, year);
+ALTER TABLE config ALTER COLUMN id DROP DEFAULT;
+ALTER TABLE config DROP COLUMN portfolio_id;`,
+			SQLite: `CREATE TABLE config_by_id (
+    id INT NOT NULL,
+    year INT NOT NULL,
+    cost_method VARCHAR(20) NOT NULL,
+    PRIMARY KEY (id, year)
+);
+INSERT INTO config_by_id (id, year, cost_method) SELECT portfolio_id, year, cost_method FROM config;
+DROP TABLE config;
+ALTER TABLE config_by_id RENAME TO config;`,
+		},
+	},
+	{
+		Version: 6,
+		Name:    "add import batches",
+		Up: map[Dialect]string{
+			MySQL: `CREATE TABLE import_batches (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    "path" VARCHAR(255) NOT NULL,
+    sha256 CHAR(64) NOT NULL,
+    exchange VARCHAR(50) NOT NULL,
+    portfolio_id INT NOT NULL DEFAULT 0,
+    account VARCHAR(50) NOT NULL DEFAULT '',
+    imported_at DATETIME NOT NULL,
+    row_count INT NOT NULL,
+    rejected_count INT NOT NULL DEFAULT 0,
+    INDEX (sha256)
+);
+
+CREATE TABLE quarantined_rows (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    batch_id INT NOT NULL,
+    line INT NOT NULL,
+    column_name VARCHAR(100) NOT NULL,
+    reason VARCHAR(1024) NOT NULL,
+    INDEX (batch_id)
+);
+` + importBatchesUp,
+			SQLite: `CREATE TABLE import_batches (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    "path" VARCHAR(255) NOT NULL,
+    sha256 CHAR(64) NOT NULL,
+    exchange VARCHAR(50) NOT NULL,
+    portfolio_id INT NOT NULL DEFAULT 0,
+    account VARCHAR(50) NOT NULL DEFAULT '',
+    imported_at DATETIME NOT NULL,
+    row_count INT NOT NULL,
+    rejected_count INT NOT NULL DEFAULT 0
+);
+CREATE INDEX import_batches_sha256 ON import_batches (sha256);
+
+CREATE TABLE quarantined_rows (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    batch_id INT NOT NULL,
+    line INT NOT NULL,
+    column_name VARCHAR(100) NOT NULL,
+    reason VARCHAR(1024) NOT NULL
+);
+CREATE INDEX quarantined_rows_batch_id ON quarantined_rows (batch_id);
+` + importBatchesUp,
+		},
+		Down: map[Dialect]string{
+			MySQL: forEach(historyTables, `DROP INDEX %[1]s_batch_id ON %[1]s;
+DROP INDEX %[1]s_portfolio_id_row_key ON %[1]s;
+ALTER TABLE %[1]s DROP COLUMN batch_id;
+ALTER TABLE %[1]s DROP COLUMN row_key;`) + `
+DROP TABLE quarantined_rows;
+DROP TABLE import_batches;`,
+		},
+	},
+	{
+		Version: 7,
+		Name:    "add histories of more exchanges",
+		Up: map[Dialect]string{
+			MySQL: `CREATE TABLE bf_collaterals (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    "date" DATETIME NOT NULL,
+    currency VARCHAR(10) NOT NULL,
+    "change" DECIMAL(20, 10) NOT NULL,
+    amount DECIMAL(20, 10) NOT NULL,
+    reason_type INT(1) NOT NULL,
+    reason VARCHAR(100) NOT NULL,
+    portfolio_id INT NOT NULL DEFAULT 0,
+    account VARCHAR(50) NOT NULL DEFAULT '',
+    batch_id INT,
+    row_key CHAR(64),
+    INDEX ("date"),
+    INDEX (batch_id),
+    UNIQUE(portfolio_id, row_key)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE poloniex_lendings (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    currency VARCHAR(10) NOT NULL,
+    rate DECIMAL(20, 10) NOT NULL,
+    amount DECIMAL(20, 10) NOT NULL,
+    duration DECIMAL(20, 10) NOT NULL,
+    interest DECIMAL(20, 10) NOT NULL,
+    fee DECIMAL(20, 10) NOT NULL,
+    earned DECIMAL(20, 10) NOT NULL,
+    "open" DATETIME NOT NULL,
+    "close" DATETIME NOT NULL,
+    portfolio_id INT NOT NULL DEFAULT 0,
+    account VARCHAR(50) NOT NULL DEFAULT '',
+    batch_id INT,
+    row_key CHAR(64),
+    INDEX ("close"),
+    INDEX (batch_id),
+    UNIQUE(portfolio_id, row_key)
+);
+
+CREATE TABLE poloniex_borrowings (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    currency VARCHAR(10) NOT NULL,
+    rate DECIMAL(20, 10) NOT NULL,
+    amount DECIMAL(20, 10) NOT NULL,
+    duration DECIMAL(20, 10) NOT NULL,
+    total_fee DECIMAL(20, 10) NOT NULL,
+    "open" DATETIME NOT NULL,
+    "close" DATETIME NOT NULL,
+    portfolio_id INT NOT NULL DEFAULT 0,
+    account VARCHAR(50) NOT NULL DEFAULT '',
+    batch_id INT,
+    row_key CHAR(64),
+    INDEX ("close"),
+    INDEX (batch_id),
+    UNIQUE(portfolio_id, row_key)
+);
+
+CREATE TABLE koinly_transactions (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    "date" DATETIME NOT NULL,
+    sent_amount DECIMAL(20, 10) NOT NULL,
+    sent_currency VARCHAR(10) NOT NULL,
+    received_amount DECIMAL(20, 10) NOT NULL,
+    received_currency VARCHAR(10) NOT NULL,
+    fee_amount DECIMAL(20, 10) NOT NULL,
+    fee_currency VARCHAR(10) NOT NULL,
+    net_worth_amount DECIMAL(20, 10),
+    net_worth_currency VARCHAR(10),
+    label VARCHAR(30) NOT NULL,
+    "description" VARCHAR(255) NOT NULL,
+    tx_hash VARCHAR(100) NOT NULL,
+    portfolio_id INT NOT NULL DEFAULT 0,
+    account VARCHAR(50) NOT NULL DEFAULT '',
+    batch_id INT,
+    row_key CHAR(64),
+    INDEX ("date"),
+    INDEX (batch_id),
+    UNIQUE(portfolio_id, row_key)
+);
+
+CREATE TABLE cointracking_trades (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    "type" VARCHAR(40) NOT NULL,
+    buy_amount DECIMAL(20, 10) NOT NULL,
+    buy_currency VARCHAR(10) NOT NULL,
+    sell_amount DECIMAL(20, 10) NOT NULL,
+    sell_currency VARCHAR(10) NOT NULL,
+    fee_amount DECIMAL(20, 10) NOT NULL,
+    fee_currency VARCHAR(10) NOT NULL,
+    exchange VARCHAR(100) NOT NULL,
+    "group" VARCHAR(100) NOT NULL,
+    comment VARCHAR(255) NOT NULL,
+    "date" DATETIME NOT NULL,
+    portfolio_id INT NOT NULL DEFAULT 0,
+    account VARCHAR(50) NOT NULL DEFAULT '',
+    batch_id INT,
+    row_key CHAR(64),
+    INDEX ("date"),
+    INDEX (batch_id),
+    UNIQUE(portfolio_id, row_key)
+);
+
+CREATE TABLE ledger_entries (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    version INT NOT NULL,
+    tid VARCHAR(100) NOT NULL,
+    "time" DATETIME NOT NULL,
+    wallet VARCHAR(50) NOT NULL,
+    "type" VARCHAR(20) NOT NULL,
+    currency VARCHAR(10) NOT NULL,
+    quantity DECIMAL(20, 10) NOT NULL,
+    counter_currency VARCHAR(10) NOT NULL,
+    counter_quantity DECIMAL(20, 10),
+    fee_currency VARCHAR(10) NOT NULL,
+    fee_quantity DECIMAL(20, 10) NOT NULL,
+    "description" VARCHAR(255) NOT NULL,
+    portfolio_id INT NOT NULL DEFAULT 0,
+    account VARCHAR(50) NOT NULL DEFAULT '',
+    batch_id INT,
+    row_key CHAR(64),
+    INDEX ("time"),
+    INDEX (tid),
+    INDEX (batch_id),
+    UNIQUE(portfolio_id, row_key)
+);`,
+			SQLite: `CREATE TABLE bf_collaterals (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    "date" DATETIME NOT NULL,
+    currency VARCHAR(10) NOT NULL,
+    "change" TEXT NOT NULL,
+    amount TEXT NOT NULL,
+    reason_type INT(1) NOT NULL,
+    reason VARCHAR(100) NOT NULL,
+    portfolio_id INT NOT NULL DEFAULT 0,
+    account VARCHAR(50) NOT NULL DEFAULT '',
+    batch_id INT,
+    row_key CHAR(64),
+    UNIQUE(portfolio_id, row_key)
+);
+CREATE INDEX bf_collaterals_date ON bf_collaterals ("date");
+CREATE INDEX bf_collaterals_batch_id ON bf_collaterals (batch_id);
+
+CREATE TABLE poloniex_lendings (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    currency VARCHAR(10) NOT NULL,
+    rate TEXT NOT NULL,
+    amount TEXT NOT NULL,
+    duration TEXT NOT NULL,
+    interest TEXT NOT NULL,
+    fee TEXT NOT NULL,
+    earned TEXT NOT NULL,
+    "open" DATETIME NOT NULL,
+    "close" DATETIME NOT NULL,
+    portfolio_id INT NOT NULL DEFAULT 0,
+    account VARCHAR(50) NOT NULL DEFAULT '',
+    batch_id INT,
+    row_key CHAR(64),
+    UNIQUE(portfolio_id, row_key)
+);
+CREATE INDEX poloniex_lendings_close ON poloniex_lendings ("close");
+CREATE INDEX poloniex_lendings_batch_id ON poloniex_lendings (batch_id);
+
+CREATE TABLE poloniex_borrowings (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    currency VARCHAR(10) NOT NULL,
+    rate TEXT NOT NULL,
+    amount TEXT NOT NULL,
+    duration TEXT NOT NULL,
+    total_fee TEXT NOT NULL,
+    "open" DATETIME NOT NULL,
+    "close" DATETIME NOT NULL,
+    portfolio_id INT NOT NULL DEFAULT 0,
+    account VARCHAR(50) NOT NULL DEFAULT '',
+    batch_id INT,
+    row_key CHAR(64),
+    UNIQUE(portfolio_id, row_key)
+);
+CREATE INDEX poloniex_borrowings_close ON poloniex_borrowings ("close");
+CREATE INDEX poloniex_borrowings_batch_id ON poloniex_borrowings (batch_id);
+
+CREATE TABLE koinly_transactions (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    "date" DATETIME NOT NULL,
+    sent_amount TEXT NOT NULL,
+    sent_currency VARCHAR(10) NOT NULL,
+    received_amount TEXT NOT NULL,
+    received_currency VARCHAR(10) NOT NULL,
+    fee_amount TEXT NOT NULL,
+    fee_currency VARCHAR(10) NOT NULL,
+    net_worth_amount TEXT,
+    net_worth_currency VARCHAR(10),
+    label VARCHAR(30) NOT NULL,
+    "description" VARCHAR(255) NOT NULL,
+    tx_hash VARCHAR(100) NOT NULL,
+    portfolio_id INT NOT NULL DEFAULT 0,
+    account VARCHAR(50) NOT NULL DEFAULT '',
+    batch_id INT,
+    row_key CHAR(64),
+    UNIQUE(portfolio_id, row_key)
+);
+CREATE INDEX koinly_transactions_date ON koinly_transactions ("date");
+CREATE INDEX koinly_transactions_batch_id ON koinly_transactions (batch_id);
+
+CREATE TABLE cointracking_trades (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    "type" VARCHAR(40) NOT NULL,
+    buy_amount TEXT NOT NULL,
+    buy_currency VARCHAR(10) NOT NULL,
+    sell_amount TEXT NOT NULL,
+    sell_currency VARCHAR(10) NOT NULL,
+    fee_amount TEXT NOT NULL,
+    fee_currency VARCHAR(10) NOT NULL,
+    exchange VARCHAR(100) NOT NULL,
+    "group" VARCHAR(100) NOT NULL,
+    comment VARCHAR(255) NOT NULL,
+    "date" DATETIME NOT NULL,
+    portfolio_id INT NOT NULL DEFAULT 0,
+    account VARCHAR(50) NOT NULL DEFAULT '',
+    batch_id INT,
+    row_key CHAR(64),
+    UNIQUE(portfolio_id, row_key)
+);
+CREATE INDEX cointracking_trades_date ON cointracking_trades ("date");
+CREATE INDEX cointracking_trades_batch_id ON cointracking_trades (batch_id);
+
+CREATE TABLE ledger_entries (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    version INT NOT NULL,
+    tid VARCHAR(100) NOT NULL,
+    "time" DATETIME NOT NULL,
+    wallet VARCHAR(50) NOT NULL,
+    "type" VARCHAR(20) NOT NULL,
+    currency VARCHAR(10) NOT NULL,
+    quantity TEXT NOT NULL,
+    counter_currency VARCHAR(10) NOT NULL,
+    counter_quantity TEXT,
+    fee_currency VARCHAR(10) NOT NULL,
+    fee_quantity TEXT NOT NULL,
+    "description" VARCHAR(255) NOT NULL,
+    portfolio_id INT NOT NULL DEFAULT 0,
+    account VARCHAR(50) NOT NULL DEFAULT '',
+    batch_id INT,
+    row_key CHAR(64),
+    UNIQUE(portfolio_id, row_key)
+);
+CREATE INDEX ledger_entries_time ON ledger_entries ("time");
+CREATE INDEX ledger_entries_tid ON ledger_entries (tid);
+CREATE INDEX ledger_entries_batch_id ON ledger_entries (batch_id);`,
+		},
+		Down: both(`DROP TABLE bf_collaterals;
+DROP TABLE poloniex_lendings;
+DROP TABLE poloniex_borrowings;
+DROP TABLE koinly_transactions;
+DROP TABLE cointracking_trades;
+DROP TABLE ledger_entries;`),
+	},
+	{
+		Version: 8,
+		Name:    "add pipeline stages",
+		Up: both(`CREATE TABLE pipeline_stages (
+    portfolio_id INT NOT NULL DEFAULT 0,
+    name VARCHAR(50) NOT NULL,
+    fingerprint CHAR(64) NOT NULL,
+    completed_at DATETIME NOT NULL,
+    PRIMARY KEY (portfolio_id, name)
+);`),
+		Down: both(`DROP TABLE pipeline_stages;`),
+	},
+	{
+		Version: 9,
+		Name:    "add translation watermarks",
+		Up: both(`CREATE TABLE translation_watermarks (
+    portfolio_id INT NOT NULL DEFAULT 0,
+    exchange VARCHAR(50) NOT NULL,
+    raw_table VARCHAR(64) NOT NULL,
+    raw_id INT NOT NULL,
+    translated_at DATETIME NOT NULL,
+    PRIMARY KEY (portfolio_id, exchange, raw_table)
+);`),
+		Down: both(`DROP TABLE translation_watermarks;`),
+	},
+	{
+		Version: 10,
+		Name:    "count translated rows",
+		Up: both(`ALTER TABLE translation_watermarks ADD COLUMN row_count INT NOT NULL DEFAULT 0;
+ALTER TABLE translation_watermarks ADD COLUMN row_checksum BIGINT NOT NULL DEFAULT 0;`),
+		Down: map[Dialect]string{
+			MySQL: `ALTER TABLE translation_watermarks DROP COLUMN row_count;
+ALTER TABLE translation_watermarks DROP COLUMN row_checksum;`,
+		},
+	},
+	{
+		Version: 11,
+		Name:    "add calculation years",
+		Up: both(`CREATE TABLE calculation_years (
+    portfolio_id INT NOT NULL DEFAULT 0,
+    year INT NOT NULL,
+    method CHAR(10) NOT NULL,
+    fiat VARCHAR(10) NOT NULL,
+    events_fingerprint CHAR(64) NOT NULL,
+    carry_in_fingerprint CHAR(64) NOT NULL,
+    balances_fingerprint CHAR(64) NOT NULL,
+    calculated_at DATETIME NOT NULL,
+    PRIMARY KEY (portfolio_id, year)
+);`),
+		Down: both(`DROP TABLE calculation_years;`),
+	},
+	{
+		Version: 12,
+		Name:    "digest inputs of calculation years",
+		Up: both(`ALTER TABLE calculation_years ADD COLUMN transactions_digest CHAR(64) NOT NULL DEFAULT '';
+ALTER TABLE calculation_years ADD COLUMN prices_watermark CHAR(64) NOT NULL DEFAULT '';`),
+		Down: map[Dialect]string{
+			MySQL: `ALTER TABLE calculation_years DROP COLUMN transactions_digest;
+ALTER TABLE calculation_years DROP COLUMN prices_watermark;`,
+		},
+	},
+	{
+		Version: 13,
+		Name:    "index transactions by portfolio",
+		Up:      both(`CREATE INDEX transactions_portfolio_id_time ON transactions (portfolio_id, "time");`),
+		Down: map[Dialect]string{
+			MySQL:  `DROP INDEX transactions_portfolio_id_time ON transactions;`,
+			SQLite: `DROP INDEX transactions_portfolio_id_time;`,
+		},
+	},
+	{
+		Version: 14,
+		Name:    "add normalized events",
+		Up: map[Dialect]string{
+			MySQL: `CREATE TABLE normalized_events (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    wallet VARCHAR(10) NOT NULL,
+    event_id VARCHAR(255) NOT NULL,
+    "type" VARCHAR(20) NOT NULL,
+    asset VARCHAR(10) NOT NULL,
+    qty DECIMAL(20, 10) NOT NULL,
+    jpy_value DECIMAL(20, 10),
+    direction VARCHAR(10) NOT NULL,
+    "time" DATETIME NOT NULL,
+    portfolio_id INT NOT NULL DEFAULT 0,
+    account VARCHAR(50) NOT NULL DEFAULT '',
+    batch_id INT,
+    row_key CHAR(64),
+    INDEX ("time"),
+    INDEX (batch_id),
+    UNIQUE(portfolio_id, row_key)
+);`,
+			SQLite: `CREATE TABLE normalized_events (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    wallet VARCHAR(10) NOT NULL,
+    event_id VARCHAR(255) NOT NULL,
+    "type" VARCHAR(20) NOT NULL,
+    asset VARCHAR(10) NOT NULL,
+    qty TEXT NOT NULL,
+    jpy_value TEXT,
+    direction VARCHAR(10) NOT NULL,
+    "time" DATETIME NOT NULL,
+    portfolio_id INT NOT NULL DEFAULT 0,
+    account VARCHAR(50) NOT NULL DEFAULT '',
+    batch_id INT,
+    row_key CHAR(64),
+    UNIQUE(portfolio_id, row_key)
+);
+CREATE INDEX normalized_events_time ON normalized_events ("time");
+CREATE INDEX normalized_events_batch_id ON normalized_events (batch_id);`,
+		},
+		Down: both(`DROP TABLE normalized_events;`),
+	},
+}
+
+// portfoliosUp adds portfolios, which own rows of the other tables by portfolio_id
+var portfoliosUp = `CREATE TABLE portfolios (
+    id INT PRIMARY KEY,
+    name VARCHAR(50) NOT NULL,
+    fiat VARCHAR(10) NOT NULL,
+    timezone VARCHAR(50) NOT NULL,
+    UNIQUE(name)
+);
+ALTER TABLE transactions ADD COLUMN portfolio_id INT NOT NULL DEFAULT 0;
+ALTER TABLE "event" ADD COLUMN portfolio_id INT NOT NULL DEFAULT 0;
+ALTER TABLE "entry" ADD COLUMN portfolio_id INT NOT NULL DEFAULT 0;
+ALTER TABLE balance ADD COLUMN portfolio_id INT NOT NULL DEFAULT 0;
+` + forEach(historyTables, `ALTER TABLE %[1]s ADD COLUMN portfolio_id INT NOT NULL DEFAULT 0;`) + `
+CREATE INDEX event_portfolio_id_time ON "event" (portfolio_id, "time");
+CREATE INDEX entry_portfolio_id_time ON "entry" (portfolio_id, "time");
+CREATE INDEX balance_portfolio_id_year ON balance (portfolio_id, year);
+CREATE UNIQUE INDEX bf_transactions_portfolio_id_source_id ON bf_transactions (portfolio_id, source_id);`
+
+// importBatchesUp adds the batches and the keys of imported rows to the histories
+var importBatchesUp = forEach(historyTables, `ALTER TABLE %[1]s ADD COLUMN batch_id INT;
+ALTER TABLE %[1]s ADD COLUMN row_key CHAR(64);
+CREATE INDEX %[1]s_batch_id ON %[1]s (batch_id);
+CREATE UNIQUE INDEX %[1]s_portfolio_id_row_key ON %[1]s (portfolio_id, row_key);`)
+
+// both returns statements which are the same in all dialects
+func both(statements string) map[Dialect]string {
+	return map[Dialect]string{
+		MySQL:  statements,
+		SQLite: statements,
+	}
+}
+
+// forEach repeats statements for each table, which is referred as %[1]s in them
+func forEach(tables []string, statements string) string {
+	repeated := make([]string, 0, len(tables))
+	for _, table := range tables {
+		repeated = append(repeated, fmt.Sprintf(statements, table))
+	}
+	return strings.Join(repeated, "\n")
+}
@@ -0,0 +1,169 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package normalized imports events normalized by eupholio-normalizer
+// (see eupholio-core/doc/08-normalizer-interface.md).
+package normalized
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+)
+
+const WalletCode = "NORMALIZED"
+
+// Event types
+const (
+	TypeAcquire  = "Acquire"
+	TypeDispose  = "Dispose"
+	TypeIncome   = "Income"
+	TypeTransfer = "Transfer"
+)
+
+// Transfer directions
+const (
+	DirectionIn  = "In"
+	DirectionOut = "Out"
+)
+
+// Event is a normalized event, whose values are in JPY
+type Event struct {
+	Type        string    `json:"type"`
+	ID          string    `json:"id"`
+	Asset       string    `json:"asset"`
+	Qty         Decimal   `json:"qty"`
+	JpyCost     Decimal   `json:"jpy_cost,omitempty"`
+	JpyProceeds Decimal   `json:"jpy_proceeds,omitempty"`
+	JpyValue    Decimal   `json:"jpy_value,omitempty"`
+	Direction   string    `json:"direction,omitempty"`
+	Ts          time.Time `json:"ts"`
+}
+
+// Decimal is a decimal encoded as a JSON string (or a number)
+type Decimal struct {
+	*decimal.Big
+}
+
+// UnmarshalJSON decodes a string or a number
+func (d *Decimal) UnmarshalJSON(b []byte) error {
+	s := strings.Trim(string(b), "\"")
+	if s == "null" {
+		return nil
+	}
+	v, ok := new(decimal.Big).SetString(s)
+	if !ok {
+		return fmt.Errorf("invalid decimal %s", string(b))
+	}
+	d.Big = v
+	return nil
+}
+
+// MarshalJSON encodes a decimal as a string
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	if d.Big == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(fmt.Sprintf("%f", d.Big))
+}
+
+// Validate checks fields required by the type of the event
+func (e *Event) Validate() error {
+	if e.ID == "" {
+		return fmt.Errorf("id is not specified")
+	}
+	if e.Asset == "" {
+		return fmt.Errorf("asset is not specified")
+	}
+	if e.Qty.Big == nil || e.Qty.Sign() <= 0 {
+		return fmt.Errorf("qty should be positive")
+	}
+	if e.Ts.IsZero() {
+		return fmt.Errorf("ts is not specified")
+	}
+	switch e.Type {
+	case TypeAcquire, TypeDispose, TypeIncome:
+	case TypeTransfer:
+		if e.Direction != DirectionIn && e.Direction != DirectionOut {
+			return fmt.Errorf("unknown direction '%s'", e.Direction)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown type '%s'", e.Type)
+	}
+	if value := e.Value(); value.Big == nil || value.Sign() < 0 {
+		return fmt.Errorf("JPY value of %s should not be negative", e.Type)
+	}
+	return nil
+}
+
+// Value returns the JPY value of the event given by the field of its type, which is empty for a transfer
+func (e *Event) Value() Decimal {
+	switch e.Type {
+	case TypeAcquire:
+		return e.JpyCost
+	case TypeDispose:
+		return e.JpyProceeds
+	case TypeIncome:
+		return e.JpyValue
+	}
+	return Decimal{}
+}
+
+// SetValue sets the JPY value of the event to the field of its type
+func (e *Event) SetValue(value Decimal) {
+	switch e.Type {
+	case TypeAcquire:
+		e.JpyCost = value
+	case TypeDispose:
+		e.JpyProceeds = value
+	case TypeIncome:
+		e.JpyValue = value
+	}
+}
+
+// Decode decodes an array of events, or an input object of eupholio-core-cli which has events
+func Decode(reader io.Reader) ([]*Event, error) {
+	b, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	b = bytes.TrimSpace(b)
+	var events []*Event
+	if len(b) > 0 && b[0] == '{' {
+		var input struct {
+			Events []*Event `json:"events"`
+		}
+		err = json.Unmarshal(b, &input)
+		events = input.Events
+	} else {
+		err = json.Unmarshal(b, &events)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
@@ -0,0 +1,124 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package normalized
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+// Extractor for events normalized by eupholio-normalizer
+type Extractor struct {
+	wallet string
+}
+
+// NewExtractor create an extractor which marks events with a wallet (up to 10 characters), which tells outputs of the normalizer apart
+func NewExtractor(wallet string) (*Extractor, error) {
+	if wallet == "" {
+		wallet = WalletCode
+	}
+	if len(wallet) > 10 {
+		return nil, fmt.Errorf("wallet %s is too long", wallet)
+	}
+	return &Extractor{
+		wallet: wallet,
+	}, nil
+}
+
+// Execute performs ETL
+func (e *Extractor) Execute(ctx context.Context, db boil.ContextExecutor, reader io.Reader, options ...eupholio.Option) error {
+	config := &eupholio.Config{}
+	for _, o := range options {
+		o(config)
+	}
+
+	rows, err := e.extract(reader)
+	if errs, ok := err.(eupholio.RowErrors); ok {
+		err = config.HandleRowErrors(errs)
+	}
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		err := config.InsertRow(ctx, db, models.TableNames.NormalizedEvents, row)
+		if err != nil {
+			if config.Debug {
+				log.Print(row)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// extract decodes events, and reports all events which cannot be imported by their positions in the array
+func (e *Extractor) extract(reader io.Reader) (models.NormalizedEventSlice, error) {
+	events, err := Decode(reader)
+	if err != nil {
+		return nil, err
+	}
+	if len(events) == 0 {
+		log.Println("no event found")
+	}
+
+	var rows models.NormalizedEventSlice
+	var errs eupholio.RowErrors
+	ids := make(map[string]struct{})
+	for i, event := range events {
+		err := event.Validate()
+		if _, ok := ids[event.ID]; ok && err == nil {
+			err = fmt.Errorf("duplicate id %s", event.ID)
+		}
+		if err != nil {
+			errs = append(errs, &eupholio.RowError{Line: i + 1, Err: err})
+			continue
+		}
+		ids[event.ID] = struct{}{}
+		rows = append(rows, e.row(event))
+	}
+	if len(errs) > 0 {
+		return rows, errs
+	}
+	return rows, nil
+}
+
+// row makes a row of normalized_events
+func (e *Extractor) row(event *Event) *models.NormalizedEvent {
+	return &models.NormalizedEvent{
+		Wallet:    e.wallet,
+		EventID:   event.ID,
+		Type:      event.Type,
+		Asset:     event.Asset,
+		Qty:       types.NewDecimal(event.Qty.Big),
+		JpyValue:  types.NewNullDecimal(event.Value().Big),
+		Direction: event.Direction,
+		Time:      event.Ts.UTC(),
+	}
+}
@@ -0,0 +1,138 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package normalized
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/currency"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+func TestDecodeNormalizerFixtures(t *testing.T) {
+	paths, err := filepath.Glob("../../eupholio-normalizer/tests/fixtures/normalizer/*.normalized.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) == 0 {
+		t.Fatal("no fixture found")
+	}
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		events, err := Decode(f)
+		f.Close()
+		if err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		for _, e := range events {
+			if err := e.Validate(); err != nil {
+				t.Errorf("%s: %s: %v", path, e.ID, err)
+			}
+		}
+	}
+}
+
+func TestTranslateEvents(t *testing.T) {
+	input := `{"method":"moving_average","tax_year":2026,"events":[
+  {"type":"Acquire","id":"x-1:acquire","asset":"ETH","qty":"1","jpy_cost":"300000","ts":"2026-01-01T00:00:00Z"},
+  {"type":"Dispose","id":"x-1:dispose","asset":"BTC","qty":"0.05","jpy_proceeds":"300000","ts":"2026-01-01T00:00:00Z"},
+  {"type":"Income","id":"s-1","asset":"ETH","qty":"0.01","jpy_value":3000,"ts":"2026-01-02T00:00:00Z"},
+  {"type":"Transfer","id":"t-1","asset":"ETH","qty":"1","direction":"Out","ts":"2026-01-03T00:00:00Z"}
+]}`
+	extractor, err := NewExtractor("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows, err := extractor.extract(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	groups := GroupRows(rows)
+	if len(groups) != 3 || len(groups[0]) != 2 {
+		t.Fatalf("unexpected groups %v", groups)
+	}
+
+	var types []string
+	for i, g := range groups {
+		var es []*Event
+		for _, row := range g {
+			es = append(es, EventOf(row))
+		}
+		if d := Description(es); i == 0 && d != "x-1: acquire ETH, dispose BTC" {
+			t.Errorf("unexpected description %s", d)
+		}
+		for _, e := range TranslateEvents(&models.Transaction{ID: 1}, es, currency.USD) {
+			types = append(types, e.Type)
+			if e.BaseQuantity.Big.Sign() == 0 && e.BaseCurrency != "USD" {
+				t.Errorf("expected %s %s without cost in USD but %s", e.Type, e.Currency, e.BaseCurrency)
+			}
+			if e.BaseQuantity.Big.Sign() != 0 && e.BaseCurrency != "JPY" {
+				t.Errorf("expected %s %s valued in JPY but %s", e.Type, e.Currency, e.BaseCurrency)
+			}
+		}
+	}
+	expected := []string{
+		eupholio.EventTypeBuy, eupholio.EventTypeSell,
+		eupholio.EventTypeBuy, eupholio.EventTypeSell, eupholio.EventTypeBuy,
+		eupholio.EventTypeWithdraw,
+	}
+	if strings.Join(types, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected %v but %v", expected, types)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	input := `[
+  {"type":"Acquire","id":"a","asset":"BTC","qty":"1","ts":"2026-01-01T00:00:00Z"},
+  {"type":"Swap","id":"b","asset":"BTC","qty":"1","ts":"2026-01-01T00:00:00Z"},
+  {"type":"Transfer","id":"c","asset":"BTC","qty":"1","direction":"Up","ts":"2026-01-01T00:00:00Z"},
+  {"type":"Income","id":"d","asset":"BTC","qty":"0","jpy_value":"1","ts":"2026-01-01T00:00:00Z"}
+]`
+	events, err := Decode(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range events {
+		if err := e.Validate(); err == nil {
+			t.Errorf("%s: expected an error", e.ID)
+		}
+	}
+
+	input = `[
+  {"type":"Transfer","id":"a","asset":"BTC","qty":"1","direction":"In","ts":"2026-01-01T00:00:00Z"},
+  {"type":"Transfer","id":"a","asset":"BTC","qty":"1","direction":"Out","ts":"2026-01-02T00:00:00Z"}
+]`
+	extractor, err := NewExtractor("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = extractor.extract(strings.NewReader(input))
+	if errs, ok := err.(eupholio.RowErrors); !ok || len(errs) != 1 || errs[0].Line != 2 {
+		t.Errorf("expected a duplicate id at event 2 but %v", err)
+	}
+}
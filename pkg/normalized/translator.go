@@ -0,0 +1,165 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package normalized
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/currency"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+// Translator is a translator for events normalized by eupholio-normalizer
+type Translator struct {
+	repository Repository
+	fiat       currency.Symbol
+}
+
+// NewTranslator create a translator for normalized events, whose JPY values are converted to the fiat of the portfolio
+func NewTranslator(repo Repository, fiat currency.Symbol) *Translator {
+	return &Translator{
+		repository: repo,
+		fiat:       fiat,
+	}
+}
+
+// Translate stores extracted transaction data to transaction table
+func (t *Translator) Translate(ctx context.Context, repo eupholio.Repository, start, end time.Time) error {
+	n, err := repo.DeleteTransaction(ctx, WalletCode, start, end)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		log.Println("deleted", n, "entries from events")
+	}
+
+	rows, err := t.repository.FindEvents(ctx, start, end)
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		log.Println("no transction found")
+	}
+
+	var events models.EventSlice
+	for _, group := range GroupRows(rows) {
+		// the row id of the first event identifies the transaction as long as the batch is kept
+		transaction, err := repo.CreateTransaction(ctx, group[0].Time, WalletCode, group[0].Account, group[0].ID)
+		if err != nil {
+			return err
+		}
+		es := make([]*Event, 0, len(group))
+		for _, row := range group {
+			es = append(es, EventOf(row))
+		}
+		transaction.Description = fmt.Sprintf("%s: %s", group[0].Wallet, Description(es))
+		if err := repo.UpdateTransaction(ctx, transaction); err != nil {
+			return err
+		}
+		events = append(events, TranslateEvents(transaction, es, t.fiat)...)
+	}
+
+	return repo.CreateEvents(ctx, events)
+}
+
+// GroupRows groups events split from a transaction in order of appearance, whose account, wallet, time and
+// prefix of ids ("<prefix>:acquire", "<prefix>:dispose") are the same
+func GroupRows(rows models.NormalizedEventSlice) []models.NormalizedEventSlice {
+	var groups []models.NormalizedEventSlice
+	index := make(map[[4]string]int)
+	for _, row := range rows {
+		key := [4]string{row.Account, row.Wallet, idPrefix(row.EventID), row.Time.UTC().Format(time.RFC3339Nano)}
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], row)
+	}
+	return groups
+}
+
+// EventOf restores an event from a row of normalized_events
+func EventOf(row *models.NormalizedEvent) *Event {
+	e := &Event{
+		Type:      row.Type,
+		ID:        row.EventID,
+		Asset:     row.Asset,
+		Qty:       Decimal{row.Qty.Big},
+		Direction: row.Direction,
+		Ts:        row.Time,
+	}
+	e.SetValue(Decimal{row.JpyValue.Big})
+	return e
+}
+
+func idPrefix(id string) string {
+	if i := strings.LastIndex(id, ":"); i > 0 {
+		return id[:i]
+	}
+	return id
+}
+
+// Description returns a description of a transaction of grouped events
+func Description(group []*Event) string {
+	var ss []string
+	for _, e := range group {
+		ss = append(ss, fmt.Sprintf("%s %s", strings.ToLower(e.Type), e.Asset))
+	}
+	return fmt.Sprintf("%s: %s", idPrefix(group[0].ID), strings.Join(ss, ", "))
+}
+
+// TranslateEvents translates normalized events to events valued in JPY, which are converted to the fiat of the portfolio.
+// Income is translated to acquisition without cost, earning and re-acquisition in the same manner as other translators.
+func TranslateEvents(transaction *models.Transaction, group []*Event, fiat currency.Symbol) models.EventSlice {
+	jpy := currency.JPY.String()
+	zero := decimal.New(0, 0)
+	var events models.EventSlice
+	for _, e := range group {
+		newEvent := eupholio.NewEventFunc(e.Ts, transaction.ID)
+		switch e.Type {
+		case TypeAcquire:
+			events = append(events, newEvent(eupholio.EventTypeBuy, e.Asset, e.Qty.Big, jpy, e.JpyCost.Big))
+		case TypeDispose:
+			events = append(events, newEvent(eupholio.EventTypeSell, e.Asset, e.Qty.Big, jpy, e.JpyProceeds.Big))
+		case TypeIncome:
+			buy := newEvent(eupholio.EventTypeBuy, e.Asset, e.Qty.Big, fiat.String(), zero)
+			sell := newEvent(eupholio.EventTypeSell, e.Asset, e.Qty.Big, jpy, e.JpyValue.Big)
+			buy2 := newEvent(eupholio.EventTypeBuy, e.Asset, e.Qty.Big, jpy, e.JpyValue.Big)
+			events = append(events, buy, sell, buy2)
+		case TypeTransfer:
+			if e.Direction == DirectionIn {
+				events = append(events, newEvent(eupholio.EventTypeDeposit, e.Asset, e.Qty.Big, fiat.String(), zero))
+			} else {
+				events = append(events, newEvent(eupholio.EventTypeWithdraw, e.Asset, e.Qty.Big, fiat.String(), zero))
+			}
+		}
+	}
+	return events
+}
@@ -0,0 +1,49 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package deposit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ericlagergren/decimal"
+)
+
+func TestExtract(t *testing.T) {
+	deposits, errs, err := extract(strings.NewReader(testDepositCsv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+	if len(deposits) != 1 {
+		t.Fatalf("expected 1 deposit but %d", len(deposits))
+	}
+	d := deposits[0]
+	if d.Currency != "BTC" || d.Amount.Big.Cmp(decimal.New(2, 2)) != 0 || d.Address != "addr1" || d.Status != "COMPLETE" {
+		t.Errorf("unexpected deposit %s %s %s %s", d.Currency, d.Amount.Big, d.Address, d.Status)
+	}
+}
+
+var testDepositCsv = `Date,Currency,Amount,Address,Status
+2020-01-03 08:00:00,BTC,0.02,addr1,COMPLETE
+`
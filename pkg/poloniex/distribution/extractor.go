@@ -54,12 +54,15 @@ func (e *Extractor) Execute(ctx context.Context, db boil.ContextExecutor, reader
 		o(config)
 	}
 
-	dists, err := extract(reader)
+	dists, errs, err := extract(reader)
 	if err != nil {
 		return err
 	}
+	if err := config.HandleRowErrors(errs); err != nil {
+		return err
+	}
 	for _, tr := range dists {
-		err := tr.Insert(ctx, db, boil.Infer())
+		err := config.InsertRow(ctx, db, models.TableNames.PoloniexDistributions, tr)
 		if err != nil {
 			if config.Debug {
 				log.Print(tr)
@@ -72,22 +75,25 @@ func (e *Extractor) Execute(ctx context.Context, db boil.ContextExecutor, reader
 }
 
 // extract extracts transactions from a reader
-func extract(reader io.Reader) (models.PoloniexDistributionSlice, error) {
+func extract(reader io.Reader) (models.PoloniexDistributionSlice, eupholio.RowErrors, error) {
 	rows, err := extractRecords(reader)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var dists models.PoloniexDistributionSlice
+	var errs eupholio.RowErrors
 
-	for _, row := range rows {
+	for i, row := range rows {
 		date, err := row.Date()
 		if err != nil {
-			return nil, err
+			errs = append(errs, eupholio.NewRowError(i+2, err))
+			continue
 		}
 		amount, err := row.GetAsDecimal(AmountColumn)
 		if err != nil {
-			return nil, err
+			errs = append(errs, eupholio.NewRowError(i+2, err))
+			continue
 		}
 		deposit := &models.PoloniexDistribution{
 			ID:       0,
@@ -99,7 +105,7 @@ func extract(reader io.Reader) (models.PoloniexDistributionSlice, error) {
 		dists = append(dists, deposit)
 	}
 
-	return dists, nil
+	return dists, errs, nil
 }
 
 func extractRecords(reader io.Reader) ([]Record, error) {
@@ -166,7 +172,11 @@ func (r Record) Get(name string) string {
 
 func (r Record) Date() (time.Time, error) {
 	date := r.Get(DateColumn)
-	return time.ParseInLocation(recordTimeFormat, date, time.UTC)
+	t, err := time.ParseInLocation(recordTimeFormat, date, time.UTC)
+	if err != nil {
+		return time.Time{}, &eupholio.ColumnError{Column: DateColumn, Err: err}
+	}
+	return t, nil
 }
 
 func (r Record) GetAsDecimal(name string) (*decimal.Big, error) {
@@ -174,6 +184,6 @@ func (r Record) GetAsDecimal(name string) (*decimal.Big, error) {
 	if b, ok := new(decimal.Big).SetString(s); ok {
 		return b, nil
 	} else {
-		return nil, fmt.Errorf("invalid decimal %s", s)
+		return nil, &eupholio.ColumnError{Column: name, Err: fmt.Errorf("invalid decimal %s", s)}
 	}
 }
@@ -0,0 +1,54 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package lending
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+)
+
+func TestExtract(t *testing.T) {
+	lendings, errs, err := extract(strings.NewReader(testLendingCsv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lendings) != 1 {
+		t.Fatalf("expected 1 lending but %d", len(lendings))
+	}
+	if len(errs) != 1 || errs[0].Line != 3 || errs[0].Column != CloseColumn {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+	l := lendings[0]
+	if l.Currency != "BTC" || l.Earned.Big.Cmp(decimal.New(85, 6)) != 0 || l.Fee.Big.Cmp(decimal.New(-15, 6)) != 0 {
+		t.Errorf("unexpected lending %s %s %s", l.Currency, l.Earned.Big, l.Fee.Big)
+	}
+	if !l.Open.Equal(time.Date(2020, 1, 21, 10, 0, 0, 0, time.UTC)) || !l.Close.Equal(time.Date(2020, 1, 23, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected period %v - %v", l.Open, l.Close)
+	}
+}
+
+var testLendingCsv = `Currency,Rate,Amount,Duration,Interest,Fee,Earned,Open,Close
+BTC,0.0001,0.5,2,0.0001,-0.000015,0.000085,2020-01-21 10:00:00,2020-01-23 10:00:00
+BTC,0.0001,0.5,2,0.0001,-0.000015,0.000085,2020-01-21 10:00:00,2020/01/23
+`
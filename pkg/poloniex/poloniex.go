@@ -22,8 +22,10 @@ package poloniex
 
 import (
 	"github.com/eupholio/eupholio/pkg/eupholio"
+	"github.com/eupholio/eupholio/pkg/poloniex/borrowing"
 	"github.com/eupholio/eupholio/pkg/poloniex/deposit"
 	"github.com/eupholio/eupholio/pkg/poloniex/distribution"
+	"github.com/eupholio/eupholio/pkg/poloniex/lending"
 	"github.com/eupholio/eupholio/pkg/poloniex/repository"
 	"github.com/eupholio/eupholio/pkg/poloniex/trade"
 	"github.com/eupholio/eupholio/pkg/poloniex/withdrawal"
@@ -48,3 +50,11 @@ func NewWithdrawalExtractor() eupholio.Extractor {
 func NewDistributionExtractor() eupholio.Extractor {
 	return distribution.NewExtractor()
 }
+
+func NewLendingExtractor() eupholio.Extractor {
+	return lending.NewExtractor()
+}
+
+func NewBorrowingExtractor() eupholio.Extractor {
+	return borrowing.NewExtractor()
+}
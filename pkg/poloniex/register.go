@@ -0,0 +1,97 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package poloniex
+
+import (
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/currency"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+	"github.com/eupholio/eupholio/pkg/poloniex/borrowing"
+	"github.com/eupholio/eupholio/pkg/poloniex/deposit"
+	"github.com/eupholio/eupholio/pkg/poloniex/distribution"
+	"github.com/eupholio/eupholio/pkg/poloniex/lending"
+	"github.com/eupholio/eupholio/pkg/poloniex/repository"
+	"github.com/eupholio/eupholio/pkg/poloniex/trade"
+	"github.com/eupholio/eupholio/pkg/poloniex/withdrawal"
+)
+
+func init() {
+	eupholio.RegisterExchange(&eupholio.Exchange{
+		Name:        "poloniex",
+		DisplayName: "poloniex",
+		FileTypes: []*eupholio.FileType{
+			{
+				Name:      "trades",
+				Match:     eupholio.CSVMatch(trade.ValidateColumnNames),
+				Extractor: func(*time.Location) eupholio.Extractor { return NewTradeExtractor() },
+			},
+			{
+				Name:      "deposits",
+				Match:     eupholio.CSVMatch(deposit.ValidateColumnNames),
+				Extractor: func(*time.Location) eupholio.Extractor { return NewDepositExtractor() },
+			},
+			{
+				Name:      "withdrawals",
+				Match:     eupholio.CSVMatch(withdrawal.ValidateColumnNames),
+				Extractor: func(*time.Location) eupholio.Extractor { return NewWithdrawalExtractor() },
+			},
+			{
+				Name:      "distributions",
+				Match:     eupholio.CSVMatch(distribution.ValidateColumnNames),
+				Extractor: func(*time.Location) eupholio.Extractor { return NewDistributionExtractor() },
+			},
+			{
+				Name:      "lendingHistory",
+				Match:     eupholio.CSVMatch(lending.ValidateColumnNames),
+				Extractor: func(*time.Location) eupholio.Extractor { return NewLendingExtractor() },
+			},
+			{
+				Name:      "borrowingHistory",
+				Match:     eupholio.CSVMatch(borrowing.ValidateColumnNames),
+				Extractor: func(*time.Location) eupholio.Extractor { return NewBorrowingExtractor() },
+			},
+		},
+		Translator: func(db boil.ContextExecutor, fiat currency.Symbol) eupholio.Translator {
+			return NewTranslator(repository.NewRepository(db), fiat)
+		},
+		RawTables: []eupholio.RawTable{
+			// an order can be filled by several trades
+			{Name: models.TableNames.PoloniexTrades, TimeColumn: "date", KeyColumns: []string{"order_number", "date", "type", "price", "amount"}},
+			{Name: models.TableNames.PoloniexDeposits, TimeColumn: "date"},
+			{Name: models.TableNames.PoloniexWithdrawals, TimeColumn: "date"},
+			{Name: models.TableNames.PoloniexDistributions, TimeColumn: "date"},
+			{Name: models.TableNames.PoloniexLendings, TimeColumn: "close"},
+			{Name: models.TableNames.PoloniexBorrowings, TimeColumn: "close"},
+		},
+		WalletCodes: map[string]string{
+			WalletCode:             "PO",
+			depositWalletCode:      "POd",
+			withdrawalWalletCode:   "POw",
+			distributionWalletCode: "POa",
+			lendingWalletCode:      "POl",
+			borrowingWalletCode:    "POb",
+		},
+	})
+}
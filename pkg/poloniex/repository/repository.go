@@ -30,15 +30,18 @@ import (
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 
 	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/eupholio"
 )
 
-const timeFormat = "2006/01/02 15:04:05"
+const timeFormat = "2006-01-02 15:04:05"
 
 type Repository interface {
 	FindTrades(ctx context.Context, start, end time.Time) (models.PoloniexTradeSlice, error)
 	FindDeposits(ctx context.Context, start, end time.Time) (models.PoloniexDepositSlice, error)
 	FindWithdrawals(ctx context.Context, start, end time.Time) (models.PoloniexWithdrawalSlice, error)
 	FindDistributions(ctx context.Context, start, end time.Time) (models.PoloniexDistributionSlice, error)
+	FindLendings(ctx context.Context, start, end time.Time) (models.PoloniexLendingSlice, error)
+	FindBorrowings(ctx context.Context, start, end time.Time) (models.PoloniexBorrowingSlice, error)
 }
 
 func NewRepository(db boil.ContextExecutor) Repository {
@@ -52,9 +55,10 @@ type repository struct {
 }
 
 func (r *repository) FindTrades(ctx context.Context, start, end time.Time) (models.PoloniexTradeSlice, error) {
-	s := start.Format(timeFormat)
-	e := end.Format(timeFormat)
+	s := start.UTC().Format(timeFormat)
+	e := end.UTC().Format(timeFormat)
 	ts, err := models.PoloniexTrades(
+		eupholio.InPortfolio(ctx),
 		qm.Where("date >= ? AND date < ?", s, e),
 		qm.OrderBy("date ASC"),
 	).All(ctx, r.db)
@@ -66,9 +70,10 @@ func (r *repository) FindTrades(ctx context.Context, start, end time.Time) (mode
 }
 
 func (r *repository) FindDeposits(ctx context.Context, start, end time.Time) (models.PoloniexDepositSlice, error) {
-	s := start.Format(timeFormat)
-	e := end.Format(timeFormat)
+	s := start.UTC().Format(timeFormat)
+	e := end.UTC().Format(timeFormat)
 	ds, err := models.PoloniexDeposits(
+		eupholio.InPortfolio(ctx),
 		qm.Where("date >= ? AND date < ?", s, e),
 		qm.OrderBy("date ASC"),
 	).All(ctx, r.db)
@@ -80,9 +85,10 @@ func (r *repository) FindDeposits(ctx context.Context, start, end time.Time) (mo
 }
 
 func (r *repository) FindWithdrawals(ctx context.Context, start, end time.Time) (models.PoloniexWithdrawalSlice, error) {
-	s := start.Format(timeFormat)
-	e := end.Format(timeFormat)
+	s := start.UTC().Format(timeFormat)
+	e := end.UTC().Format(timeFormat)
 	ws, err := models.PoloniexWithdrawals(
+		eupholio.InPortfolio(ctx),
 		qm.Where("date >= ? AND date < ?", s, e),
 		qm.OrderBy("date ASC"),
 	).All(ctx, r.db)
@@ -94,9 +100,10 @@ func (r *repository) FindWithdrawals(ctx context.Context, start, end time.Time)
 }
 
 func (r *repository) FindDistributions(ctx context.Context, start, end time.Time) (models.PoloniexDistributionSlice, error) {
-	s := start.Format(timeFormat)
-	e := end.Format(timeFormat)
+	s := start.UTC().Format(timeFormat)
+	e := end.UTC().Format(timeFormat)
 	dists, err := models.PoloniexDistributions(
+		eupholio.InPortfolio(ctx),
 		qm.Where("date >= ? AND date < ?", s, e),
 		qm.OrderBy("date ASC"),
 	).All(ctx, r.db)
@@ -106,3 +113,33 @@ func (r *repository) FindDistributions(ctx context.Context, start, end time.Time
 	}
 	return dists, nil
 }
+
+func (r *repository) FindLendings(ctx context.Context, start, end time.Time) (models.PoloniexLendingSlice, error) {
+	s := start.UTC().Format(timeFormat)
+	e := end.UTC().Format(timeFormat)
+	ls, err := models.PoloniexLendings(
+		eupholio.InPortfolio(ctx),
+		qm.Where("close >= ? AND close < ?", s, e),
+		qm.OrderBy("close ASC"),
+	).All(ctx, r.db)
+	if err != nil {
+		log.Println("failed to find lending:", "start:", s, "end:", e)
+		return nil, errors.WithMessage(err, "failed to find lending")
+	}
+	return ls, nil
+}
+
+func (r *repository) FindBorrowings(ctx context.Context, start, end time.Time) (models.PoloniexBorrowingSlice, error) {
+	s := start.UTC().Format(timeFormat)
+	e := end.UTC().Format(timeFormat)
+	bs, err := models.PoloniexBorrowings(
+		eupholio.InPortfolio(ctx),
+		qm.Where("close >= ? AND close < ?", s, e),
+		qm.OrderBy("close ASC"),
+	).All(ctx, r.db)
+	if err != nil {
+		log.Println("failed to find borrowing:", "start:", s, "end:", e)
+		return nil, errors.WithMessage(err, "failed to find borrowing")
+	}
+	return bs, nil
+}
@@ -26,6 +26,13 @@ const (
 	TypeSell = "Sell"
 )
 
+// Category
+const (
+	CategoryExchange    = "Exchange"
+	CategoryMarginTrade = "Margin trade"
+	CategorySettlement  = "Settlement"
+)
+
 // Column names
 const (
 	DateColumn              = "Date"
@@ -26,6 +26,7 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"strconv"
 	"time"
 
 	"github.com/ericlagergren/decimal"
@@ -54,12 +55,15 @@ func (e *Extractor) Execute(ctx context.Context, db boil.ContextExecutor, reader
 		o(config)
 	}
 
-	trades, err := extract(reader)
+	trades, errs, err := extract(reader)
 	if err != nil {
 		return err
 	}
+	if err := config.HandleRowErrors(errs); err != nil {
+		return err
+	}
 	for _, tr := range trades {
-		err := tr.Insert(ctx, db, boil.Infer())
+		err := config.InsertRow(ctx, db, models.TableNames.PoloniexTrades, tr)
 		if err != nil {
 			if config.Debug {
 				log.Print(tr)
@@ -72,53 +76,72 @@ func (e *Extractor) Execute(ctx context.Context, db boil.ContextExecutor, reader
 }
 
 // extract extracts transactions from a reader
-func extract(reader io.Reader) (models.PoloniexTradeSlice, error) {
+func extract(reader io.Reader) (models.PoloniexTradeSlice, eupholio.RowErrors, error) {
 	rows, err := extractRecords(reader)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var trades models.PoloniexTradeSlice
+	var errs eupholio.RowErrors
 
-	for _, row := range rows {
+	for i, row := range rows {
+		// margin trades are paid with borrowed currencies, which are not modelled as positions
+		if category := row.Get(CategoryColumn); category == CategoryMarginTrade || category == CategorySettlement {
+			errs = append(errs, &eupholio.RowError{Line: i + 2, Column: CategoryColumn, Err: fmt.Errorf("unsupported category: %s", category)})
+			continue
+		}
 		date, err := row.Date()
 		if err != nil {
-			return nil, err
+			errs = append(errs, eupholio.NewRowError(i+2, err))
+			continue
 		}
 		price, err := row.GetAsDecimal(PriceColumn)
 		if err != nil {
-			return nil, err
+			errs = append(errs, eupholio.NewRowError(i+2, err))
+			continue
 		}
 		amount, err := row.GetAsDecimal(AmountColumn)
 		if err != nil {
-			return nil, err
+			errs = append(errs, eupholio.NewRowError(i+2, err))
+			continue
 		}
 		total, err := row.GetAsDecimal(TotalColumn)
 		if err != nil {
-			return nil, err
+			errs = append(errs, eupholio.NewRowError(i+2, err))
+			continue
 		}
 		baseTotalLessFee, err := row.GetAsDecimal(BaseTotalLessFeeColumn)
 		if err != nil {
-			return nil, err
+			errs = append(errs, eupholio.NewRowError(i+2, err))
+			continue
 		}
 		quoteTotalLessFee, err := row.GetAsDecimal(QuoteTotalLessFeeColumn)
 		if err != nil {
-			return nil, err
+			errs = append(errs, eupholio.NewRowError(i+2, err))
+			continue
 		}
 		feeTotal, err := row.GetAsDecimal(FeeTotalColumn)
 		if err != nil {
-			return nil, err
+			errs = append(errs, eupholio.NewRowError(i+2, err))
+			continue
+		}
+		orderNumber, err := row.OrderNumber()
+		if err != nil {
+			errs = append(errs, eupholio.NewRowError(i+2, err))
+			continue
 		}
 		trade := &models.PoloniexTrade{
 			ID:                0,
 			Date:              date,
 			Market:            row.Get(MarketColumn),
+			Category:          row.Get(CategoryColumn),
 			Type:              row.Get(TypeColumn),
 			Price:             types.NewDecimal(price),
 			Amount:            types.NewDecimal(amount),
 			Total:             types.NewDecimal(total),
 			Fee:               row.Get(FeeColumn),
-			OrderNumber:       0,
+			OrderNumber:       orderNumber,
 			BaseTotalLessFee:  types.NewDecimal(baseTotalLessFee),
 			QuoteTotalLessFee: types.NewDecimal(quoteTotalLessFee),
 			FeeCurrency:       row.Get(FeeCurrencyColumn),
@@ -127,7 +150,7 @@ func extract(reader io.Reader) (models.PoloniexTradeSlice, error) {
 		trades = append(trades, trade)
 	}
 
-	return trades, nil
+	return trades, errs, nil
 }
 
 func extractRecords(reader io.Reader) ([]Record, error) {
@@ -194,7 +217,24 @@ func (r Record) Get(name string) string {
 
 func (r Record) Date() (time.Time, error) {
 	date := r.Get(DateColumn)
-	return time.ParseInLocation(recordTimeFormat, date, time.UTC)
+	t, err := time.ParseInLocation(recordTimeFormat, date, time.UTC)
+	if err != nil {
+		return time.Time{}, &eupholio.ColumnError{Column: DateColumn, Err: err}
+	}
+	return t, nil
+}
+
+// OrderNumber returns the order number, or 0 if it is empty
+func (r Record) OrderNumber() (int64, error) {
+	s := r.Get(OrderNumberColumn)
+	if s == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, &eupholio.ColumnError{Column: OrderNumberColumn, Err: fmt.Errorf("invalid order number %s", s)}
+	}
+	return n, nil
 }
 
 func (r Record) GetAsDecimal(name string) (*decimal.Big, error) {
@@ -202,6 +242,6 @@ func (r Record) GetAsDecimal(name string) (*decimal.Big, error) {
 	if b, ok := new(decimal.Big).SetString(s); ok {
 		return b, nil
 	} else {
-		return nil, fmt.Errorf("invalid decimal %s", s)
+		return nil, &eupholio.ColumnError{Column: name, Err: fmt.Errorf("invalid decimal %s", s)}
 	}
 }
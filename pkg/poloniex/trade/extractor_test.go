@@ -0,0 +1,65 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package trade
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+)
+
+func TestExtract(t *testing.T) {
+	trades, errs, err := extract(strings.NewReader(testTradeCsv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trades) != 2 {
+		t.Fatalf("expected 2 trades but %d", len(trades))
+	}
+	if len(errs) != 2 || errs[0].Line != 4 || errs[0].Column != PriceColumn || errs[1].Line != 5 || errs[1].Column != CategoryColumn {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+	tr := trades[0]
+	if !tr.Date.Equal(time.Date(2020, 1, 5, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected date %v", tr.Date)
+	}
+	if tr.Market != "BTC/JPY" || tr.Type != TypeBuy || tr.Category != CategoryExchange || tr.FeeCurrency != "BTC" {
+		t.Errorf("unexpected trade %s %s %s %s", tr.Market, tr.Type, tr.Category, tr.FeeCurrency)
+	}
+	if tr.OrderNumber != 1001 {
+		t.Errorf("unexpected order number %d", tr.OrderNumber)
+	}
+	if tr.QuoteTotalLessFee.Big.Cmp(decimal.New(99, 3)) != 0 {
+		t.Errorf("unexpected quote total less fee %s", tr.QuoteTotalLessFee.Big)
+	}
+	if trades[1].Type != TypeSell || trades[1].BaseTotalLessFee.Big.Cmp(decimal.New(59880, 0)) != 0 {
+		t.Errorf("unexpected trade %s %s", trades[1].Type, trades[1].BaseTotalLessFee.Big)
+	}
+}
+
+var testTradeCsv = `Date,Market,Category,Type,Price,Amount,Total,Fee,Order Number,Base Total Less Fee,Quote Total Less Fee,Fee Currency,Fee Total
+2020-01-05 10:00:00,BTC/JPY,Exchange,Buy,1000000,0.1,100000,0.001,1001,-100000,0.099,BTC,0.001
+2020-01-10 10:00:00,BTC/JPY,Exchange,Sell,1200000,0.05,60000,0.002,1002,59880,-0.05,JPY,120
+2020-01-11 10:00:00,BTC/JPY,Exchange,Sell,,0.05,60000,0.002,1003,59880,-0.05,JPY,120
+2020-01-12 10:00:00,BTC/JPY,Margin trade,Buy,1000000,0.1,100000,0.001,1004,-100000,0.099,BTC,0.001
+`
@@ -28,7 +28,6 @@ import (
 	"time"
 
 	"github.com/ericlagergren/decimal"
-	"github.com/volatiletech/sqlboiler/v4/boil"
 
 	"github.com/eupholio/eupholio/models"
 	"github.com/eupholio/eupholio/pkg/currency"
@@ -37,14 +36,34 @@ import (
 	"github.com/eupholio/eupholio/pkg/poloniex/trade"
 )
 
+// Wallet codes of non-trade transactions
+const (
+	depositWalletCode      = WalletCode + "_D"
+	withdrawalWalletCode   = WalletCode + "_W"
+	distributionWalletCode = WalletCode + "_A"
+	lendingWalletCode      = WalletCode + "_L"
+	borrowingWalletCode    = WalletCode + "_B"
+)
+
+var walletCodes = []string{
+	WalletCode,
+	depositWalletCode,
+	withdrawalWalletCode,
+	distributionWalletCode,
+	lendingWalletCode,
+	borrowingWalletCode,
+}
+
 // Translator is a translator for BitTrex
 type Translator struct {
+	repository   repository.Repository
 	baseCurrency currency.Symbol
 }
 
 // NewTranslator create a translator for BitTrex
-func NewTranslator(baseCurrency currency.Symbol) *Translator {
+func NewTranslator(repo repository.Repository, baseCurrency currency.Symbol) *Translator {
 	return &Translator{
+		repository:   repo,
 		baseCurrency: baseCurrency,
 	}
 }
@@ -53,14 +72,16 @@ func NewTranslator(baseCurrency currency.Symbol) *Translator {
 func (t *Translator) Translate(ctx context.Context, repo eupholio.Repository, start, end time.Time) error {
 	fiat := t.baseCurrency.String()
 
-	poloniexRepository := repository.NewRepository(repo)
+	poloniexRepository := t.repository
 
-	n, err := repo.DeleteTransaction(ctx, WalletCode, start, end)
-	if err != nil {
-		return err
-	}
-	if n > 0 {
-		log.Println("deleted", n, "entries from events")
+	for _, walletCode := range walletCodes {
+		n, err := repo.DeleteTransaction(ctx, walletCode, start, end)
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			log.Println("deleted", n, "entries from events")
+		}
 	}
 
 	trs, err := poloniexRepository.FindTrades(ctx, start, end)
@@ -74,7 +95,7 @@ func (t *Translator) Translate(ctx context.Context, repo eupholio.Repository, st
 	var events []*models.Event
 
 	for _, tr := range trs {
-		transaction, err := repo.CreateTransaction(ctx, tr.Date, WalletCode, tr.ID)
+		transaction, err := repo.CreateTransaction(ctx, tr.Date, WalletCode, tr.Account, tr.ID)
 		if err != nil {
 			return err
 		}
@@ -84,7 +105,7 @@ func (t *Translator) Translate(ctx context.Context, repo eupholio.Repository, st
 			return err
 		}
 		transaction.Description = desc
-		if _, err := transaction.Update(ctx, repo, boil.Infer()); err != nil {
+		if err := repo.UpdateTransaction(ctx, transaction); err != nil {
 			return err
 		}
 
@@ -100,7 +121,7 @@ func (t *Translator) Translate(ctx context.Context, repo eupholio.Repository, st
 	}
 
 	for _, d := range deposits {
-		transaction, err := repo.CreateTransaction(ctx, d.Date, WalletCode+"_D", d.ID)
+		transaction, err := repo.CreateTransaction(ctx, d.Date, depositWalletCode, d.Account, d.ID)
 		if err != nil {
 			return err
 		}
@@ -118,7 +139,7 @@ func (t *Translator) Translate(ctx context.Context, repo eupholio.Repository, st
 	}
 
 	for _, w := range whs {
-		transaction, err := repo.CreateTransaction(ctx, w.Date, WalletCode+"_W", w.ID)
+		transaction, err := repo.CreateTransaction(ctx, w.Date, withdrawalWalletCode, w.Account, w.ID)
 		if err != nil {
 			return err
 		}
@@ -136,7 +157,7 @@ func (t *Translator) Translate(ctx context.Context, repo eupholio.Repository, st
 		log.Println("no deposit found")
 	}
 	for _, d := range dists {
-		transaction, err := repo.CreateTransaction(ctx, d.Date, WalletCode+"_A", d.ID)
+		transaction, err := repo.CreateTransaction(ctx, d.Date, distributionWalletCode, d.Account, d.ID)
 		if err != nil {
 			return err
 		}
@@ -145,6 +166,57 @@ func (t *Translator) Translate(ctx context.Context, repo eupholio.Repository, st
 		events = append(events, newEvent(eupholio.EventTypeBuy, d.Currency, d.Amount.Big, fiat, zero))
 	}
 
+	lendings, err := poloniexRepository.FindLendings(ctx, start, end)
+	if err != nil {
+		return err
+	}
+	if len(lendings) == 0 {
+		log.Println("no lending found")
+	}
+	for _, l := range lendings {
+		if l.Earned.Big.Sign() == 0 {
+			continue
+		}
+		transaction, err := repo.CreateTransaction(ctx, l.Close, lendingWalletCode, l.Account, l.ID)
+		if err != nil {
+			return err
+		}
+		// interest is an income in the lent currency, valued at the market price on the close date
+		newEvent := eupholio.NewEventFunc(l.Close, transaction.ID)
+		zero := decimal.New(0, 0)
+		earned := l.Earned.Big
+		buy := newEvent(eupholio.EventTypeBuy, l.Currency, earned, fiat, zero)           // acquisition without cost
+		sell := newEvent(eupholio.EventTypeSell, l.Currency, earned, l.Currency, earned) // earning
+		buy2 := newEvent(eupholio.EventTypeBuy, l.Currency, earned, l.Currency, earned)  // income
+		events = append(events, buy, sell, buy2)
+		transaction.Description = fmt.Sprintf("lending interest %s", toString(l.Earned.Big, l.Currency))
+		if err := repo.UpdateTransaction(ctx, transaction); err != nil {
+			return err
+		}
+	}
+
+	borrowings, err := poloniexRepository.FindBorrowings(ctx, start, end)
+	if err != nil {
+		return err
+	}
+	if len(borrowings) == 0 {
+		log.Println("no borrowing found")
+	}
+	for _, b := range borrowings {
+		transaction, err := repo.CreateTransaction(ctx, b.Close, borrowingWalletCode, b.Account, b.ID)
+		if err != nil {
+			return err
+		}
+		// borrow fee of margin trading is paid in the borrowed currency like a withdrawal fee
+		newEvent := eupholio.NewEventFunc(b.Close, transaction.ID)
+		zero := decimal.New(0, 0)
+		events = append(events, newEvent(eupholio.EventTypeFee, b.Currency, b.TotalFee.Big, fiat, zero))
+		transaction.Description = fmt.Sprintf("borrow fee %s", toString(b.TotalFee.Big, b.Currency))
+		if err := repo.UpdateTransaction(ctx, transaction); err != nil {
+			return err
+		}
+	}
+
 	err = repo.CreateEvents(ctx, events)
 	if err != nil {
 		return err
@@ -157,7 +229,7 @@ func (t *Translator) Translate(ctx context.Context, repo eupholio.Repository, st
 // | -------------------- | ---------------------------- |
 // | Date                 | Time(2006-01-02 15:04:05)    |
 // | Market               | Pair (BASE)/(QUOTE)          |
-// | Category             | "Exchange"                   |
+// | Category             | "Exchange" / "Margin trade"  |
 // | Type                 | "Sell" / "Buy"               |
 // | Price                | BASE price in QUOTE          |
 // | Amount               | BASE quantity                |
@@ -177,6 +249,11 @@ func (t *Translator) Translate(ctx context.Context, repo eupholio.Repository, st
 func (t *Translator) translateTransaction(ctx context.Context, repository eupholio.Repository, transaction *models.Transaction, tr *models.PoloniexTrade) (models.EventSlice, string, error) {
 	newEvent := eupholio.NewEventFunc(tr.Date, transaction.ID)
 
+	// margin trades imported before they were rejected
+	if tr.Category == trade.CategoryMarginTrade || tr.Category == trade.CategorySettlement {
+		return nil, "", fmt.Errorf("trade %d: unsupported category %s", tr.ID, tr.Category)
+	}
+
 	desc := ""
 	cs := strings.Split(tr.Market, "/")
 	if len(cs) < 2 {
@@ -0,0 +1,128 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package poloniex
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/currency"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+	"github.com/eupholio/eupholio/pkg/repository/memory"
+)
+
+type expectedEvent struct {
+	typ, currency, quantity, baseCurrency, baseQuantity string
+}
+
+func TestTranslate(t *testing.T) {
+	ctx := context.Background()
+	date := func(day int) time.Time { return time.Date(2020, 1, day, 10, 0, 0, 0, time.UTC) }
+	raw := &memory.PoloniexRepository{
+		Trades: models.PoloniexTradeSlice{
+			{ID: 1, Date: date(5), Market: "BTC/JPY", Category: "Exchange", Type: "Buy", Price: types.NewDecimal(decimal.New(1000000, 0)), Amount: types.NewDecimal(decimal.New(1, 1)), Total: types.NewDecimal(decimal.New(100000, 0)),
+				BaseTotalLessFee: types.NewDecimal(decimal.New(-100000, 0)), QuoteTotalLessFee: types.NewDecimal(decimal.New(99, 3)), FeeCurrency: "BTC", FeeTotal: types.NewDecimal(decimal.New(1, 3))},
+			{ID: 2, Date: date(10), Market: "BTC/JPY", Category: "Exchange", Type: "Sell", Price: types.NewDecimal(decimal.New(1200000, 0)), Amount: types.NewDecimal(decimal.New(5, 2)), Total: types.NewDecimal(decimal.New(60000, 0)),
+				BaseTotalLessFee: types.NewDecimal(decimal.New(59880, 0)), QuoteTotalLessFee: types.NewDecimal(decimal.New(-5, 2)), FeeCurrency: "JPY", FeeTotal: types.NewDecimal(decimal.New(120, 0))},
+		},
+		Deposits: models.PoloniexDepositSlice{
+			{ID: 1, Date: date(3), Currency: "BTC", Amount: types.NewDecimal(decimal.New(2, 2))},
+		},
+		Withdrawals: models.PoloniexWithdrawalSlice{
+			{ID: 1, Date: date(20), Currency: "BTC", Amount: types.NewDecimal(decimal.New(1, 2)), FeeDeducted: types.NewDecimal(decimal.New(1, 4)), AmountMinusFee: types.NewDecimal(decimal.New(99, 4))},
+		},
+		Lendings: models.PoloniexLendingSlice{
+			{ID: 1, Currency: "BTC", Rate: types.NewDecimal(decimal.New(1, 4)), Amount: types.NewDecimal(decimal.New(5, 1)), Duration: types.NewDecimal(decimal.New(2, 0)), Interest: types.NewDecimal(decimal.New(1, 4)), Fee: types.NewDecimal(decimal.New(-15, 6)), Earned: types.NewDecimal(decimal.New(85, 6)),
+				Open: date(21), Close: date(23)},
+			{ID: 2, Currency: "BTC", Rate: types.NewDecimal(decimal.New(1, 4)), Amount: types.NewDecimal(decimal.New(5, 1)), Duration: types.NewDecimal(decimal.New(0, 0)), Interest: types.NewDecimal(decimal.New(0, 0)), Fee: types.NewDecimal(decimal.New(0, 0)), Earned: types.NewDecimal(decimal.New(0, 0)),
+				Open: date(24), Close: date(24)},
+		},
+		Borrowings: models.PoloniexBorrowingSlice{
+			{ID: 1, Currency: "JPY", Rate: types.NewDecimal(decimal.New(2, 4)), Amount: types.NewDecimal(decimal.New(10000, 0)), Duration: types.NewDecimal(decimal.New(1, 0)), TotalFee: types.NewDecimal(decimal.New(2, 0)),
+				Open: date(25), Close: date(26)},
+		},
+	}
+	repo := memory.New(currency.JPY)
+	start, end := date(1), date(31)
+	if err := NewTranslator(raw, currency.JPY).Translate(ctx, repo, start, end); err != nil {
+		t.Fatal(err)
+	}
+
+	trs, err := eupholio.FindEventsOfTransactions(ctx, repo, 2020, time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string][]expectedEvent{
+		depositWalletCode: {
+			{eupholio.EventTypeDeposit, "BTC", "0.02", "JPY", "0"},
+		},
+		WalletCode: {
+			{eupholio.EventTypeSell, "JPY", "100000", "JPY", "100000"},
+			{eupholio.EventTypeBuy, "BTC", "0.099", "JPY", "100000"},
+			{eupholio.EventTypeCommission, "BTC", "0.001", "JPY", "1000"},
+			{eupholio.EventTypeSell, "BTC", "0.05", "JPY", "60000"},
+			{eupholio.EventTypeBuy, "JPY", "59880", "JPY", "60000"},
+			{eupholio.EventTypeCommission, "JPY", "120", "JPY", "120"},
+		},
+		withdrawalWalletCode: {
+			{eupholio.EventTypeWithdraw, "BTC", "0.01", "JPY", "0"},
+			{eupholio.EventTypeFee, "BTC", "0.0001", "JPY", "0"},
+		},
+		borrowingWalletCode: {
+			{eupholio.EventTypeFee, "JPY", "2", "JPY", "0"},
+		},
+		lendingWalletCode: { // income valued at the market price
+			{eupholio.EventTypeBuy, "BTC", "0.000085", "JPY", "0"},
+			{eupholio.EventTypeSell, "BTC", "0.000085", "BTC", "0.000085"},
+			{eupholio.EventTypeBuy, "BTC", "0.000085", "BTC", "0.000085"},
+		},
+	}
+	actual := make(map[string][]*models.Event)
+	for _, tr := range trs {
+		actual[tr.WalletCode] = append(actual[tr.WalletCode], tr.Events...)
+	}
+	if len(actual) != len(expected) {
+		t.Errorf("expected %d wallets but %d", len(expected), len(actual))
+	}
+	for walletCode, es := range expected {
+		as := actual[walletCode]
+		if len(as) != len(es) {
+			t.Errorf("%s: expected %d events but %d", walletCode, len(es), len(as))
+			continue
+		}
+		for i, e := range es {
+			a := as[i]
+			quantity, _ := new(decimal.Big).SetString(e.quantity)
+			baseQuantity, _ := new(decimal.Big).SetString(e.baseQuantity)
+			if a.Type != e.typ || a.Currency != e.currency || a.Quantity.Big.Cmp(quantity) != 0 ||
+				a.BaseCurrency != e.baseCurrency || a.BaseQuantity.Big.Cmp(baseQuantity) != 0 {
+				t.Errorf("%s event %d: expected %v but %s %s %s %s %s", walletCode, i, e,
+					a.Type, a.Currency, a.Quantity.Big, a.BaseCurrency, a.BaseQuantity.Big)
+			}
+		}
+	}
+}
@@ -54,12 +54,15 @@ func (e *Extractor) Execute(ctx context.Context, db boil.ContextExecutor, reader
 		o(config)
 	}
 
-	trades, err := extract(reader)
+	trades, errs, err := extract(reader)
 	if err != nil {
 		return err
 	}
+	if err := config.HandleRowErrors(errs); err != nil {
+		return err
+	}
 	for _, tr := range trades {
-		err := tr.Insert(ctx, db, boil.Infer())
+		err := config.InsertRow(ctx, db, models.TableNames.PoloniexWithdrawals, tr)
 		if err != nil {
 			if config.Debug {
 				log.Print(tr)
@@ -72,30 +75,35 @@ func (e *Extractor) Execute(ctx context.Context, db boil.ContextExecutor, reader
 }
 
 // extract extracts transactions from a reader
-func extract(reader io.Reader) (models.PoloniexWithdrawalSlice, error) {
+func extract(reader io.Reader) (models.PoloniexWithdrawalSlice, eupholio.RowErrors, error) {
 	rows, err := extractRecords(reader)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var withdrawals models.PoloniexWithdrawalSlice
+	var errs eupholio.RowErrors
 
-	for _, row := range rows {
+	for i, row := range rows {
 		date, err := row.Date()
 		if err != nil {
-			return nil, err
+			errs = append(errs, eupholio.NewRowError(i+2, err))
+			continue
 		}
 		amount, err := row.GetAsDecimal(AmountColumn)
 		if err != nil {
-			return nil, err
+			errs = append(errs, eupholio.NewRowError(i+2, err))
+			continue
 		}
 		feeDeducted, err := row.GetAsDecimal(FeeDeductedColumn)
 		if err != nil {
-			return nil, err
+			errs = append(errs, eupholio.NewRowError(i+2, err))
+			continue
 		}
 		amountMinusFee, err := row.GetAsDecimal(AmountMinusFeeColumn)
 		if err != nil {
-			return nil, err
+			errs = append(errs, eupholio.NewRowError(i+2, err))
+			continue
 		}
 		withdrawal := &models.PoloniexWithdrawal{
 			ID:             0,
@@ -110,7 +118,7 @@ func extract(reader io.Reader) (models.PoloniexWithdrawalSlice, error) {
 		withdrawals = append(withdrawals, withdrawal)
 	}
 
-	return withdrawals, nil
+	return withdrawals, errs, nil
 }
 
 func extractRecords(reader io.Reader) ([]Record, error) {
@@ -177,7 +185,11 @@ func (r Record) Get(name string) string {
 
 func (r Record) Date() (time.Time, error) {
 	date := r.Get(DateColumn)
-	return time.ParseInLocation(recordTimeFormat, date, time.UTC)
+	t, err := time.ParseInLocation(recordTimeFormat, date, time.UTC)
+	if err != nil {
+		return time.Time{}, &eupholio.ColumnError{Column: DateColumn, Err: err}
+	}
+	return t, nil
 }
 
 func (r Record) GetAsDecimal(name string) (*decimal.Big, error) {
@@ -185,6 +197,6 @@ func (r Record) GetAsDecimal(name string) (*decimal.Big, error) {
 	if b, ok := new(decimal.Big).SetString(s); ok {
 		return b, nil
 	} else {
-		return nil, fmt.Errorf("invalid decimal %s", s)
+		return nil, &eupholio.ColumnError{Column: name, Err: fmt.Errorf("invalid decimal %s", s)}
 	}
 }
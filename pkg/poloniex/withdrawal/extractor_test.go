@@ -0,0 +1,50 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package withdrawal
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ericlagergren/decimal"
+)
+
+func TestExtract(t *testing.T) {
+	withdrawals, errs, err := extract(strings.NewReader(testWithdrawalCsv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+	if len(withdrawals) != 1 {
+		t.Fatalf("expected 1 withdrawal but %d", len(withdrawals))
+	}
+	w := withdrawals[0]
+	if w.Currency != "BTC" || w.Amount.Big.Cmp(decimal.New(1, 2)) != 0 ||
+		w.FeeDeducted.Big.Cmp(decimal.New(1, 4)) != 0 || w.AmountMinusFee.Big.Cmp(decimal.New(99, 4)) != 0 {
+		t.Errorf("unexpected withdrawal %s %s %s %s", w.Currency, w.Amount.Big, w.FeeDeducted.Big, w.AmountMinusFee.Big)
+	}
+}
+
+var testWithdrawalCsv = `Date,Currency,Amount,Fee Deducted,Amount - Fee,Address,Status
+2020-01-20 09:00:00,BTC,0.01,0.0001,0.0099,addr2,COMPLETE
+`
@@ -23,25 +23,201 @@ package querycmd
 import (
 	"context"
 	"database/sql"
+	"encoding/csv"
 	"fmt"
 	"io"
+	"sort"
+	"strconv"
+	"time"
 
+	"github.com/ericlagergren/decimal"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/eupholio/eupholio/models"
 	"github.com/eupholio/eupholio/pkg/currency"
+	"github.com/eupholio/eupholio/pkg/eupholio"
 	"github.com/eupholio/eupholio/pkg/repository"
 )
 
-func QueryBalance(ctx context.Context, writer io.Writer, tx *sql.Tx, year int, fiat currency.Symbol, source string, of OutputFormat) error {
+// AccountBalance is a balance of a currency held in an account. Price is the cost price of the portfolio,
+// since costs are averaged over all the accounts, and Profit is the sum of profits of closes in the account.
+// Quantity includes transfers from and to the account.
+type AccountBalance struct {
+	Account   string
+	Year      int
+	Currency  string
+	Beginning *decimal.Big
+	Open      *decimal.Big
+	Close     *decimal.Big
+	Quantity  *decimal.Big
+	Price     *decimal.Big
+	Profit    *decimal.Big
+}
+
+// QueryBalance shows balances of a year. Balances are grouped by account if byAccount is true,
+// or shown for an account if account is not empty.
+func QueryBalance(ctx context.Context, writer io.Writer, tx *sql.Tx, year int, loc *time.Location, fiat currency.Symbol, source, account string, byAccount bool, of OutputFormat) error {
 	repo := repository.New(tx, fiat)
 	balances, err := repo.FindBalancesByYear(ctx, year)
 	if err != nil {
 		return err
 	}
+	if !byAccount && account == "" {
+		switch of {
+		case OutputFormatTable:
+			NewTableWriter(writer).PrintBalances(balances)
+		case OutputFormatCSV:
+			var rows [][]string
+			for _, b := range balances {
+				rows = append(rows, []string{strconv.Itoa(b.Year), b.Currency, b.BeginningQuantity.String(), b.OpenQuantity.String(),
+					b.CloseQuantity.String(), b.Quantity.String(), b.Price.String(), b.Profit.String()})
+			}
+			return writeCSV(writer, []string{"Year", "Currency", "Beginning", "Open", "Close", "Quantity", "Price", "Profit"}, rows)
+		default:
+			return fmt.Errorf("unknown output format %s", of)
+		}
+		return nil
+	}
+
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+	end := time.Date(year+1, time.January, 1, 0, 0, 0, 0, loc)
+	mods := []qm.QueryMod{eupholio.InPortfolio(ctx), qm.Where("time < ?", end.UTC())}
+	transactions, err := models.Transactions(mods...).All(ctx, tx)
+	if err != nil {
+		return err
+	}
+	events, err := models.Events(mods...).All(ctx, tx)
+	if err != nil {
+		return err
+	}
+	entries, err := repo.FindEntriesByStartAndEnd(ctx, start, end)
+	if err != nil {
+		return err
+	}
+	abs := AccountBalances(year, start, fiat.String(), balances, transactions, events, entries)
+	if account != "" {
+		var filtered []*AccountBalance
+		for _, b := range abs {
+			if b.Account == account {
+				filtered = append(filtered, b)
+			}
+		}
+		abs = filtered
+	}
+
 	switch of {
 	case OutputFormatTable:
-		NewTableWriter(writer).PrintBalances(balances)
+		NewTableWriter(writer).PrintAccountBalances(abs)
 	case OutputFormatCSV:
+		var rows [][]string
+		for _, b := range abs {
+			rows = append(rows, []string{b.Account, strconv.Itoa(b.Year), b.Currency, b.Beginning.String(), b.Open.String(),
+				b.Close.String(), b.Quantity.String(), b.Price.String(), b.Profit.String()})
+		}
+		return writeCSV(writer, []string{"Account", "Year", "Currency", "Beginning", "Open", "Close", "Quantity", "Price", "Profit"}, rows)
 	default:
 		return fmt.Errorf("unknown output format %s", of)
 	}
 	return nil
 }
+
+// AccountBalances splits balances of a year starting at start by account, from events up to the end of the year
+// and entries of the year. Balances of the fiat currency are not included as they are not in balances.
+func AccountBalances(year int, start time.Time, fiat string, balances models.BalanceSlice, transactions models.TransactionSlice, events models.EventSlice, entries models.EntrySlice) []*AccountBalance {
+	prices := make(map[string]*decimal.Big)
+	for _, b := range balances {
+		prices[b.Currency] = b.Price.Big
+	}
+	accounts := make(map[int]string)
+	for _, t := range transactions {
+		accounts[t.ID] = t.Account
+	}
+
+	abs := make(map[[2]string]*AccountBalance)
+	balanceOf := func(account, currency string) *AccountBalance {
+		key := [2]string{account, currency}
+		b, ok := abs[key]
+		if !ok {
+			price := prices[currency]
+			if price == nil {
+				price = new(decimal.Big)
+			}
+			b = &AccountBalance{
+				Account:   account,
+				Year:      year,
+				Currency:  currency,
+				Beginning: new(decimal.Big),
+				Open:      new(decimal.Big),
+				Close:     new(decimal.Big),
+				Quantity:  new(decimal.Big),
+				Price:     price,
+				Profit:    new(decimal.Big),
+			}
+			abs[key] = b
+		}
+		return b
+	}
+
+	for _, e := range events {
+		account, ok := accounts[e.TransactionID]
+		if !ok || e.Currency == fiat {
+			continue
+		}
+		q := new(decimal.Big).Copy(e.Quantity.Big)
+		switch e.Type {
+		case eupholio.EventTypeBuy, eupholio.EventTypeDeposit:
+		case eupholio.EventTypeSell, eupholio.EventTypeFee, eupholio.EventTypeWithdraw:
+			q.Neg(q)
+		default:
+			continue
+		}
+		b := balanceOf(account, e.Currency)
+		b.Quantity.Add(b.Quantity, q)
+		if e.Time.Before(start) {
+			b.Beginning.Add(b.Beginning, q)
+			continue
+		}
+		switch e.Type {
+		case eupholio.EventTypeBuy:
+			b.Open.Add(b.Open, e.Quantity.Big)
+		case eupholio.EventTypeSell, eupholio.EventTypeFee:
+			b.Close.Add(b.Close, e.Quantity.Big)
+		}
+	}
+
+	for _, e := range entries {
+		account, ok := accounts[e.TransactionID]
+		if !ok || e.Type != eupholio.EntryTypeClose || e.Currency == fiat || e.Price.Big == nil {
+			continue
+		}
+		b := balanceOf(account, e.Currency)
+		cost := new(decimal.Big).Mul(e.Price.Big, e.Quantity.Big)
+		b.Profit.Add(b.Profit, new(decimal.Big).Sub(e.FiatQuantity.Big, cost))
+	}
+
+	ret := make([]*AccountBalance, 0, len(abs))
+	for _, b := range abs {
+		ret = append(ret, b)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Account != ret[j].Account {
+			return ret[i].Account < ret[j].Account
+		}
+		return ret[i].Currency < ret[j].Currency
+	})
+	return ret
+}
+
+func writeCSV(writer io.Writer, header []string, rows [][]string) error {
+	w := csv.NewWriter(writer)
+	if err := w.Write(header); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if err := w.Write(row); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
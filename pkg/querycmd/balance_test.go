@@ -0,0 +1,93 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package querycmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+func TestAccountBalances(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	before := start.Add(-time.Hour)
+	in := start.Add(time.Hour)
+	dec := func(v int64) types.Decimal { return types.NewDecimal(decimal.New(v, 0)) }
+
+	balances := models.BalanceSlice{{Year: 2020, Currency: "BTC", Price: dec(100)}}
+	transactions := models.TransactionSlice{
+		{ID: 1, Account: "personal"},
+		{ID: 2, Account: "personal"},
+		{ID: 3, Account: "corp"},
+		{ID: 4, Account: "corp"},
+	}
+	events := models.EventSlice{
+		{TransactionID: 1, Time: before, Type: eupholio.EventTypeBuy, Currency: "BTC", Quantity: dec(3)},
+		{TransactionID: 1, Time: before, Type: eupholio.EventTypeSell, Currency: "JPY", Quantity: dec(300)},
+		{TransactionID: 2, Time: in, Type: eupholio.EventTypeSell, Currency: "BTC", Quantity: dec(1)},
+		{TransactionID: 2, Time: in, Type: eupholio.EventTypeWithdraw, Currency: "BTC", Quantity: dec(1)},
+		{TransactionID: 3, Time: in, Type: eupholio.EventTypeDeposit, Currency: "BTC", Quantity: dec(1)},
+		{TransactionID: 4, Time: in, Type: eupholio.EventTypeBuy, Currency: "BTC", Quantity: dec(2)},
+	}
+	entries := models.EntrySlice{
+		{TransactionID: 2, Type: eupholio.EntryTypeClose, Currency: "BTC", Quantity: dec(1), FiatQuantity: dec(150),
+			Price: types.NewNullDecimal(decimal.New(100, 0))},
+	}
+
+	abs := AccountBalances(2020, start, "JPY", balances, transactions, events, entries)
+	expected := []struct {
+		account                                         string
+		beginning, open, close, quantity, price, profit int64
+	}{
+		{"corp", 0, 2, 0, 3, 100, 0},
+		{"personal", 3, 0, 1, 1, 100, 50},
+	}
+	if len(abs) != len(expected) {
+		t.Fatalf("expected %d balances but %d", len(expected), len(abs))
+	}
+	for i, e := range expected {
+		b := abs[i]
+		if b.Account != e.account || b.Currency != "BTC" {
+			t.Fatalf("unexpected balance of %s %s", b.Account, b.Currency)
+		}
+		for _, v := range []struct {
+			name     string
+			actual   *decimal.Big
+			expected int64
+		}{
+			{"beginning", b.Beginning, e.beginning},
+			{"open", b.Open, e.open},
+			{"close", b.Close, e.close},
+			{"quantity", b.Quantity, e.quantity},
+			{"price", b.Price, e.price},
+			{"profit", b.Profit, e.profit},
+		} {
+			if v.actual.Cmp(decimal.New(v.expected, 0)) != 0 {
+				t.Errorf("%s: expected %s %d but %s", b.Account, v.name, v.expected, v.actual)
+			}
+		}
+	}
+}
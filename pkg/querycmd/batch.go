@@ -0,0 +1,80 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package querycmd
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+func QueryImportBatches(ctx context.Context, writer io.Writer, tx *sql.Tx, of OutputFormat) error {
+	batches, err := models.ImportBatches(eupholio.InPortfolio(ctx), qm.OrderBy("id ASC")).All(ctx, tx)
+	if err != nil {
+		return err
+	}
+	switch of {
+	case OutputFormatTable:
+		NewTableWriter(writer).PrintImportBatches(batches)
+	default:
+		return fmt.Errorf("unknown output format %s", of)
+	}
+	return nil
+}
+
+// QueryQuarantinedRows shows rows quarantined in a batch, or in all batches of the portfolio of ctx if batchID is 0
+func QueryQuarantinedRows(ctx context.Context, writer io.Writer, tx *sql.Tx, batchID int, of OutputFormat) error {
+	mods := []qm.QueryMod{qm.OrderBy("batch_id ASC, line ASC")}
+	if batchID != 0 {
+		mods = append(mods, models.QuarantinedRowWhere.BatchID.EQ(batchID))
+	}
+	batches, err := models.ImportBatches(eupholio.InPortfolio(ctx)).All(ctx, tx)
+	if err != nil {
+		return err
+	}
+	paths := make(map[int]string)
+	var ids []interface{}
+	for _, b := range batches {
+		paths[b.ID] = b.Path
+		ids = append(ids, b.ID)
+	}
+	var rows models.QuarantinedRowSlice
+	if len(ids) > 0 {
+		mods = append(mods, qm.WhereIn("batch_id IN ?", ids...))
+		rows, err = models.QuarantinedRows(mods...).All(ctx, tx)
+		if err != nil {
+			return err
+		}
+	}
+	switch of {
+	case OutputFormatTable:
+		NewTableWriter(writer).PrintQuarantinedRows(rows, paths)
+	default:
+		return fmt.Errorf("unknown output format %s", of)
+	}
+	return nil
+}
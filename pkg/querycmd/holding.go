@@ -0,0 +1,121 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package querycmd
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+// Holding is the quantity of a currency held in an account
+type Holding struct {
+	Account  string
+	Currency string
+	Quantity *decimal.Big
+}
+
+// QueryHoldings shows quantities of currencies held in each account at the end of a year (or now if year is 0),
+// or in an account if account is not empty
+func QueryHoldings(ctx context.Context, writer io.Writer, tx *sql.Tx, year int, loc *time.Location, account string, of OutputFormat) error {
+	mods := []qm.QueryMod{eupholio.InPortfolio(ctx)}
+	if year != 0 {
+		end := time.Date(year+1, time.January, 1, 0, 0, 0, 0, loc)
+		mods = append(mods, qm.Where("time < ?", end.UTC()))
+	}
+	transactions, err := models.Transactions(mods...).All(ctx, tx)
+	if err != nil {
+		return err
+	}
+	accounts := make(map[int]string)
+	for _, t := range transactions {
+		accounts[t.ID] = t.Account
+	}
+	events, err := models.Events(mods...).All(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	holdings := make(map[[2]string]*Holding)
+	for _, event := range events {
+		a, ok := accounts[event.TransactionID]
+		if !ok || (account != "" && a != account) {
+			continue
+		}
+		key := [2]string{a, event.Currency}
+		h, ok := holdings[key]
+		if !ok {
+			h = &Holding{
+				Account:  a,
+				Currency: event.Currency,
+				Quantity: decimal.New(0, 0),
+			}
+			holdings[key] = h
+		}
+		switch event.Type {
+		case eupholio.EventTypeDeposit, eupholio.EventTypeBuy:
+			h.Quantity.Add(h.Quantity, event.Quantity.Big)
+		case eupholio.EventTypeWithdraw, eupholio.EventTypeSell, eupholio.EventTypeFee:
+			h.Quantity.Sub(h.Quantity, event.Quantity.Big)
+		}
+	}
+
+	hs := make([]*Holding, 0, len(holdings))
+	for _, h := range holdings {
+		hs = append(hs, h)
+	}
+	sort.Slice(hs, func(i, j int) bool {
+		if hs[i].Account != hs[j].Account {
+			return hs[i].Account < hs[j].Account
+		}
+		return hs[i].Currency < hs[j].Currency
+	})
+
+	switch of {
+	case OutputFormatTable:
+		NewTableWriter(writer).PrintHoldings(hs)
+	case OutputFormatCSV:
+		w := csv.NewWriter(writer)
+		if err := w.Write([]string{"Account", "Currency", "Quantity"}); err != nil {
+			return err
+		}
+		for _, h := range hs {
+			if err := w.Write([]string{h.Account, h.Currency, h.Quantity.String()}); err != nil {
+				return err
+			}
+		}
+		w.Flush()
+		return w.Error()
+	default:
+		return fmt.Errorf("unknown output format %s", of)
+	}
+	return nil
+}
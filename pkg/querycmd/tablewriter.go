@@ -104,3 +104,80 @@ func (t *TableWriter) PrintBalances(bs models.BalanceSlice) {
 	})
 	t.writer.Render()
 }
+
+func (t *TableWriter) PrintImportBatches(bs models.ImportBatchSlice) {
+	t.writer.SetHeader([]string{
+		"ID", "Imported at", "Exchange", "Account", "Rows", "Rejected", "Path", "SHA-256",
+	})
+	for _, b := range bs {
+		t.writer.Append([]string{
+			strconv.Itoa(b.ID),
+			b.ImportedAt.Format("2006/01/02 15:04:05"),
+			b.Exchange,
+			b.Account,
+			strconv.Itoa(b.RowCount),
+			strconv.Itoa(b.RejectedCount),
+			b.Path,
+			b.Sha256[:12],
+		})
+	}
+	t.writer.Render()
+}
+
+func (t *TableWriter) PrintHoldings(hs []*Holding) {
+	t.writer.SetHeader([]string{
+		"Account", "Currency", "Quantity",
+	})
+	for _, h := range hs {
+		t.writer.Append([]string{
+			h.Account,
+			h.Currency,
+			h.Quantity.String(),
+		})
+	}
+	t.writer.Render()
+}
+
+func (t *TableWriter) PrintQuarantinedRows(rows models.QuarantinedRowSlice, paths map[int]string) {
+	t.writer.SetHeader([]string{
+		"Batch", "Path", "Line", "Column", "Reason",
+	})
+	for _, r := range rows {
+		t.writer.Append([]string{
+			strconv.Itoa(r.BatchID),
+			paths[r.BatchID],
+			strconv.Itoa(r.Line),
+			r.ColumnName,
+			r.Reason,
+		})
+	}
+	t.writer.Render()
+}
+
+func (t *TableWriter) PrintAccountBalances(bs []*AccountBalance) {
+	t.writer.SetHeader([]string{
+		"Account", "Year", "Currency", "Beginning", "Open qty", "Close qty", "Quantity", "Price", "Profit",
+	})
+	profit := decimal.New(0, 0)
+	for _, b := range bs {
+		if b.Quantity.Sign() == 0 && b.Profit.Sign() == 0 {
+			continue
+		}
+		t.writer.Append([]string{
+			b.Account,
+			strconv.Itoa(b.Year),
+			b.Currency,
+			new(decimal.Big).Copy(b.Beginning).Round(8).String(),
+			new(decimal.Big).Copy(b.Open).Round(8).String(),
+			new(decimal.Big).Copy(b.Close).Round(8).String(),
+			new(decimal.Big).Copy(b.Quantity).Round(8).String(),
+			new(decimal.Big).Copy(b.Price).Round(8).String(),
+			new(decimal.Big).Copy(b.Profit).Round(8).String(),
+		})
+		profit.Add(profit, b.Profit)
+	}
+	t.writer.SetFooter([]string{
+		"Total", "", "", "", "", "", "", "", profit.String(),
+	})
+	t.writer.Render()
+}
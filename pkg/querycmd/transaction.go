@@ -34,18 +34,26 @@ import (
 
 	"github.com/eupholio/eupholio/pkg/currency"
 	"github.com/eupholio/eupholio/pkg/eupholio"
+	_ "github.com/eupholio/eupholio/pkg/exchanges" // registers wallet codes
 	"github.com/eupholio/eupholio/pkg/repository"
 )
 
 const timeFormat = "2006/01/02 15:04:05"
 
-func QueryTransactions(ctx context.Context, writer io.Writer, tx *sql.Tx, year int, loc *time.Location, baseCurrency, source string, of OutputFormat) error {
+// QueryTransactions shows transactions of a year, or transactions of an account if account is not empty
+func QueryTransactions(ctx context.Context, writer io.Writer, tx *sql.Tx, year int, loc *time.Location, baseCurrency, source, account string, of OutputFormat) error {
 	repo := repository.New(tx, currency.Symbol(baseCurrency))
 
-	transactions, err := eupholio.FindEntriesOfTransactions(ctx, repo, year, loc)
+	all, err := eupholio.FindEntriesOfTransactions(ctx, repo, year, loc)
 	if err != nil {
 		return err
 	}
+	var transactions []*eupholio.EntriesOfTransaction
+	for _, t := range all {
+		if account == "" || t.Account == account {
+			transactions = append(transactions, t)
+		}
+	}
 
 	switch of {
 	case OutputFormatTable:
@@ -73,7 +81,7 @@ func QueryTransactions(ctx context.Context, writer io.Writer, tx *sql.Tx, year i
 			tm := t.Time.In(loc).Format(timeFormat)
 			var debt [][]string
 			var credit [][]string
-			rem := shortWalletCode(t.WalletCode)
+			rem := remark(t.WalletCode, t.Account)
 			desc := t.Description
 			for _, e := range t.Entries {
 				currency := e.Currency
@@ -161,7 +169,7 @@ func QueryTransactions(ctx context.Context, writer io.Writer, tx *sql.Tx, year i
 			tm := t.Time.In(loc).Format(timeFormat)
 			var debt [][]string
 			var credit [][]string
-			rem := shortWalletCode(t.WalletCode)
+			rem := remark(t.WalletCode, t.Account)
 			desc := t.Description
 			for _, e := range t.Entries {
 				currency := e.Currency
@@ -227,30 +235,10 @@ func QueryTransactions(ctx context.Context, writer io.Writer, tx *sql.Tx, year i
 	return nil
 }
 
-func shortWalletCode(walletCode string) (shortCode string) {
-	switch walletCode {
-	case "BITFLYER":
-		shortCode = "BF"
-	case "COINCHECK":
-		shortCode = "CC"
-	case "BITTREX":
-		shortCode = "BT"
-	case "BITTREX_W":
-		shortCode = "BTw"
-	case "BITTREX_D":
-		shortCode = "BTd"
-	case "POLONIEX":
-		shortCode = "PO"
-	case "POLONIEX_D":
-		shortCode = "POd"
-	case "POLONIEX_W":
-		shortCode = "POw"
-	case "POLONIEX_A":
-		shortCode = "POa"
-	case "CRYPTACT_C":
-		shortCode = "CTc"
-	default:
-		shortCode = walletCode
+// remark returns the short wallet code followed by the account (ex. BF/corp)
+func remark(walletCode, account string) string {
+	if account == "" {
+		return eupholio.ShortWalletCode(walletCode)
 	}
-	return
+	return eupholio.ShortWalletCode(walletCode) + "/" + account
 }
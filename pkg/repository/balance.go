@@ -28,12 +28,14 @@ import (
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 
 	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/eupholio"
 )
 
 // Balance
 
 func (r *repository) CreateBalances(ctx context.Context, balances models.BalanceSlice) error {
 	for _, balance := range balances {
+		balance.PortfolioID = eupholio.PortfolioID(ctx)
 		err := balance.Insert(ctx, r.ContextExecutor, boil.Infer())
 		if err != nil {
 			return err
@@ -42,8 +44,16 @@ func (r *repository) CreateBalances(ctx context.Context, balances models.Balance
 	return nil
 }
 
+func (r *repository) DeleteBalancesByYear(ctx context.Context, year int) (int64, error) {
+	return models.Balances(
+		eupholio.InPortfolio(ctx),
+		qm.Where("year = ?", year),
+	).DeleteAll(ctx, r.ContextExecutor)
+}
+
 func (r *repository) FindBalanceByCurrencyAndYear(ctx context.Context, currency string, year int) (*models.Balance, error) {
 	return models.Balances(
+		eupholio.InPortfolio(ctx),
 		qm.Where("currency = ? AND year = ?", currency, year),
 		qm.Limit(1),
 	).One(ctx, r.ContextExecutor)
@@ -51,6 +61,7 @@ func (r *repository) FindBalanceByCurrencyAndYear(ctx context.Context, currency
 
 func (r *repository) FindBalancesByYear(ctx context.Context, year int) (models.BalanceSlice, error) {
 	bs, err := models.Balances(
+		eupholio.InPortfolio(ctx),
 		qm.Where("year = ?", year),
 	).All(ctx, r.ContextExecutor)
 	if err == sql.ErrNoRows {
@@ -34,11 +34,13 @@ import (
 
 func (r *repository) FindConfigByYear(ctx context.Context, year int) (*models.Config, error) {
 	c, err := models.Configs(
-		qm.Where("id = 0 AND year <= ?", year),
+		eupholio.InPortfolio(ctx),
+		qm.Where("year <= ?", year),
+		qm.OrderBy("year DESC"),
 		qm.Limit(1),
 	).One(ctx, r.ContextExecutor)
 	if err == sql.ErrNoRows {
-		return eupholio.NewDefaultConfig(year), nil
+		return eupholio.NewDefaultConfig(ctx, year), nil
 	} else if err != nil {
 		return nil, err
 	}
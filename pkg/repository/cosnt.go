@@ -20,4 +20,4 @@
 
 package repository
 
-const timeFormat = "2006/01/02 15:04:05"
+const timeFormat = "2006-01-02 15:04:05"
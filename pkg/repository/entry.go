@@ -30,12 +30,14 @@ import (
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 
 	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/eupholio"
 )
 
 // Entry
 
 func (r *repository) CreateEntries(ctx context.Context, entries models.EntrySlice) error {
 	for _, entry := range entries {
+		entry.PortfolioID = eupholio.PortfolioID(ctx)
 		err := entry.Insert(ctx, r.ContextExecutor, boil.Infer())
 		if err != nil {
 			log.Println("failed to insert", entry)
@@ -45,6 +47,15 @@ func (r *repository) CreateEntries(ctx context.Context, entries models.EntrySlic
 	return nil
 }
 
+func (r *repository) DeleteEntriesByStartAndEnd(ctx context.Context, start, end time.Time) (int64, error) {
+	s := start.UTC().Format(timeFormat)
+	e := end.UTC().Format(timeFormat)
+	return models.Entries(
+		eupholio.InPortfolio(ctx),
+		qm.Where("time >= ? AND time < ?", s, e),
+	).DeleteAll(ctx, r.ContextExecutor)
+}
+
 func (r *repository) UpdateEntries(ctx context.Context, entries models.EntrySlice) error {
 	for _, entry := range entries {
 		_, err := entry.Update(ctx, r.ContextExecutor, boil.Infer())
@@ -60,6 +71,7 @@ func (r *repository) FindEntriesByStartAndEnd(ctx context.Context, start, end ti
 	s := start.UTC().Format(timeFormat)
 	e := end.UTC().Format(timeFormat)
 	es, err := models.Entries(
+		eupholio.InPortfolio(ctx),
 		qm.Where("time >= ? AND time < ?", s, e),
 		qm.OrderBy("time ASC, id ASC"),
 	).All(ctx, r.ContextExecutor)
@@ -31,6 +31,7 @@ import (
 
 	"github.com/eupholio/eupholio/models"
 	"github.com/eupholio/eupholio/pkg/currency"
+	"github.com/eupholio/eupholio/pkg/eupholio"
 )
 
 // Event
@@ -40,6 +41,7 @@ func (r *repository) CreateEvents(ctx context.Context, events models.EventSlice)
 		if event.Quantity.Sign() == 0 {
 			continue
 		}
+		event.PortfolioID = eupholio.PortfolioID(ctx)
 		err := event.Insert(ctx, r.ContextExecutor, boil.Infer())
 		if err != nil {
 			log.Println("failed to insert", event)
@@ -51,6 +53,7 @@ func (r *repository) CreateEvents(ctx context.Context, events models.EventSlice)
 
 func (r *repository) FindEvents(ctx context.Context) (models.EventSlice, error) {
 	es, err := models.Events(
+		eupholio.InPortfolio(ctx),
 		qm.OrderBy("time ASC, id ASC"),
 	).All(ctx, r.ContextExecutor)
 	if err == sql.ErrNoRows {
@@ -63,6 +66,7 @@ func (r *repository) FindEventsByStartAndEnd(ctx context.Context, start, end tim
 	s := start.UTC().Format(timeFormat)
 	e := end.UTC().Format(timeFormat)
 	es, err := models.Events(
+		eupholio.InPortfolio(ctx),
 		qm.Where("time >= ? AND time < ?", s, e),
 		qm.OrderBy("time ASC, id ASC"),
 	).All(ctx, r.ContextExecutor)
@@ -75,7 +79,7 @@ func (r *repository) FindEventsByStartAndEnd(ctx context.Context, start, end tim
 func (r *repository) FindEventsByCurrencyStartAndEnd(ctx context.Context, cur currency.Symbol, start, end time.Time) (models.EventSlice, error) {
 	es, err := models.Events(
 		qm.InnerJoin("transactions ON events.transaction_id = transactions.id"),
-		qm.Where("currency = ? AND events.time >= ? AND events.time < ?", cur, start, end),
+		qm.Where("events.portfolio_id = ? AND currency = ? AND events.time >= ? AND events.time < ?", eupholio.PortfolioID(ctx), cur, start, end),
 		qm.OrderBy("time ASC, id ASC"),
 	).All(ctx, r.ContextExecutor)
 	if err == sql.ErrNoRows {
@@ -26,12 +26,14 @@ import (
 	"fmt"
 	"log"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 
 	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/eupholio"
 )
 
 // Market Price
@@ -56,7 +58,7 @@ func (r *repository) FindMarketPriceByCurrencyAndTime(ctx context.Context, curre
 	to := tm.Add(time.Hour * 48).Format(timeFormat)
 	price, err := models.MarketPrices(
 		qm.Where("base_currency = ? AND currency = ? AND time >= ? AND time < ?", r.baseCurrency, currency, from, to),
-		qm.OrderBy("time ASC"),
+		qm.OrderBy(priceSourceOrder(ctx)+"time ASC"),
 		qm.Limit(1),
 	).One(ctx, r.ContextExecutor)
 	if err == sql.ErrNoRows {
@@ -68,6 +70,21 @@ func (r *repository) FindMarketPriceByCurrencyAndTime(ctx context.Context, curre
 	return price, err
 }
 
+// priceSourceOrder returns an order by the priority of sources of market prices, or empty if it is not configured
+func priceSourceOrder(ctx context.Context) string {
+	sources := eupholio.DefaultsOf(ctx).PriceSources
+	if len(sources) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("CASE source")
+	for i, source := range sources {
+		fmt.Fprintf(&b, " WHEN '%s' THEN %d", strings.Replace(source, "'", "''", -1), i)
+	}
+	fmt.Fprintf(&b, " ELSE %d END, ", len(sources))
+	return b.String()
+}
+
 func (r *repository) CreateMarketPrices(ctx context.Context, marketPrices models.MarketPriceSlice) error {
 	for _, marketPrice := range marketPrices {
 		err := marketPrice.Insert(ctx, r.ContextExecutor, boil.Infer())
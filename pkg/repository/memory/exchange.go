@@ -0,0 +1,351 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/bitflyer"
+	"github.com/eupholio/eupholio/pkg/bittrex"
+	"github.com/eupholio/eupholio/pkg/coincheck"
+	"github.com/eupholio/eupholio/pkg/cointracking"
+	"github.com/eupholio/eupholio/pkg/cryptact"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+	"github.com/eupholio/eupholio/pkg/koinly"
+	"github.com/eupholio/eupholio/pkg/ledger"
+	poloniex "github.com/eupholio/eupholio/pkg/poloniex/repository"
+)
+
+// Raw rows are appended to the fields of the repositories of exchanges directly, or by their Create methods.
+// Rows are found in the portfolio of the context and in the order of their times.
+
+var (
+	_ bitflyer.Repository     = (*BitflyerRepository)(nil)
+	_ bittrex.Repository      = (*BittrexRepository)(nil)
+	_ coincheck.Repository    = (*CoincheckRepository)(nil)
+	_ cointracking.Repository = (*CointrackingRepository)(nil)
+	_ cryptact.Repository     = (*CryptactRepository)(nil)
+	_ koinly.Repository       = (*KoinlyRepository)(nil)
+	_ ledger.Repository       = (*LedgerRepository)(nil)
+	_ poloniex.Repository     = (*PoloniexRepository)(nil)
+)
+
+// rowIn returns whether a raw row is in the portfolio of the context and in the period
+func rowIn(ctx context.Context, portfolioID int, t, start, end time.Time) bool {
+	return portfolioID == eupholio.PortfolioID(ctx) && inPeriod(t, start, end)
+}
+
+// BitflyerRepository keeps raw rows of bitFlyer
+type BitflyerRepository struct {
+	Transactions models.BFTransactionSlice
+	Collaterals  models.BFCollateralSlice
+}
+
+func (r *BitflyerRepository) FindTransactions(ctx context.Context, start, end time.Time) (models.BFTransactionSlice, error) {
+	var trs models.BFTransactionSlice
+	for _, tr := range r.Transactions {
+		if rowIn(ctx, tr.PortfolioID, tr.TRDate, start, end) {
+			trs = append(trs, tr)
+		}
+	}
+	sort.SliceStable(trs, func(i, j int) bool { return trs[i].TRDate.Before(trs[j].TRDate) })
+	return trs, nil
+}
+
+func (r *BitflyerRepository) FindTransactionsByYear(ctx context.Context, year int, loc *time.Location) (models.BFTransactionSlice, error) {
+	start, end := yearPeriod(year, loc)
+	return r.FindTransactions(ctx, start, end)
+}
+
+func (r *BitflyerRepository) CreateTransactions(ctx context.Context, trs models.BFTransactionSlice) error {
+	for _, tr := range trs {
+		tr.ID = len(r.Transactions) + 1
+		tr.PortfolioID = eupholio.PortfolioID(ctx)
+		r.Transactions = append(r.Transactions, tr)
+	}
+	return nil
+}
+
+func (r *BitflyerRepository) FindSourceIDs(ctx context.Context, ids []string) (map[string]bool, error) {
+	wanted := make(map[string]bool)
+	for _, id := range ids {
+		wanted[id] = true
+	}
+	found := make(map[string]bool)
+	for _, tr := range r.Transactions {
+		if tr.PortfolioID == eupholio.PortfolioID(ctx) && tr.SourceID.Valid && wanted[tr.SourceID.String] {
+			found[tr.SourceID.String] = true
+		}
+	}
+	return found, nil
+}
+
+func (r *BitflyerRepository) FindLastExecutionID(ctx context.Context, productCode string) (int64, error) {
+	var last int64
+	for _, tr := range r.Transactions {
+		if tr.PortfolioID != eupholio.PortfolioID(ctx) || tr.Currency != strings.Replace(productCode, "_", "/", 1) {
+			continue
+		}
+		if id, ok := bitflyer.ExecutionID(tr); ok && id > last {
+			last = id
+		}
+	}
+	return last, nil
+}
+
+func (r *BitflyerRepository) FindCollaterals(ctx context.Context, start, end time.Time) (models.BFCollateralSlice, error) {
+	var cs models.BFCollateralSlice
+	for _, c := range r.Collaterals {
+		if rowIn(ctx, c.PortfolioID, c.Date, start, end) {
+			cs = append(cs, c)
+		}
+	}
+	sort.SliceStable(cs, func(i, j int) bool { return cs[i].Date.Before(cs[j].Date) })
+	return cs, nil
+}
+
+func (r *BitflyerRepository) CreateCollaterals(ctx context.Context, cs models.BFCollateralSlice) error {
+	for _, c := range cs {
+		c.ID = len(r.Collaterals) + 1
+		c.PortfolioID = eupholio.PortfolioID(ctx)
+		r.Collaterals = append(r.Collaterals, c)
+	}
+	return nil
+}
+
+// BittrexRepository keeps raw rows of Bittrex
+type BittrexRepository struct {
+	OrderHistories    models.BittrexOrderHistorySlice
+	DepositHistories  models.BittrexDepositHistorySlice
+	WithdrawHistories models.BittrexWithdrawHistorySlice
+}
+
+func (r *BittrexRepository) FindOrderHistories(ctx context.Context, start, end time.Time) (models.BittrexOrderHistorySlice, error) {
+	var hs models.BittrexOrderHistorySlice
+	for _, h := range r.OrderHistories {
+		if rowIn(ctx, h.PortfolioID, h.Timestamp, start, end) {
+			hs = append(hs, h)
+		}
+	}
+	sort.SliceStable(hs, func(i, j int) bool { return hs[i].Timestamp.Before(hs[j].Timestamp) })
+	return hs, nil
+}
+
+func (r *BittrexRepository) FindDepositHistories(ctx context.Context, start, end time.Time) (models.BittrexDepositHistorySlice, error) {
+	var hs models.BittrexDepositHistorySlice
+	for _, h := range r.DepositHistories {
+		if rowIn(ctx, h.PortfolioID, h.Timestamp, start, end) {
+			hs = append(hs, h)
+		}
+	}
+	sort.SliceStable(hs, func(i, j int) bool { return hs[i].Timestamp.Before(hs[j].Timestamp) })
+	return hs, nil
+}
+
+func (r *BittrexRepository) FindWithdrawHistories(ctx context.Context, start, end time.Time) (models.BittrexWithdrawHistorySlice, error) {
+	var hs models.BittrexWithdrawHistorySlice
+	for _, h := range r.WithdrawHistories {
+		if rowIn(ctx, h.PortfolioID, h.Timestamp, start, end) {
+			hs = append(hs, h)
+		}
+	}
+	sort.SliceStable(hs, func(i, j int) bool { return hs[i].Timestamp.Before(hs[j].Timestamp) })
+	return hs, nil
+}
+
+// CoincheckRepository keeps raw rows of Coincheck
+type CoincheckRepository struct {
+	Histories models.CoincheckHistorySlice
+}
+
+func (r *CoincheckRepository) FindHistories(ctx context.Context, start, end time.Time) (models.CoincheckHistorySlice, error) {
+	var hs models.CoincheckHistorySlice
+	for _, h := range r.Histories {
+		if rowIn(ctx, h.PortfolioID, h.Time, start, end) {
+			hs = append(hs, h)
+		}
+	}
+	sort.SliceStable(hs, func(i, j int) bool { return hs[i].Time.Before(hs[j].Time) })
+	return hs, nil
+}
+
+func (r *CoincheckRepository) FindHistoriesByYear(ctx context.Context, year int, loc *time.Location) (models.CoincheckHistorySlice, error) {
+	start, end := yearPeriod(year, loc)
+	return r.FindHistories(ctx, start, end)
+}
+
+func (r *CoincheckRepository) CreateHistories(ctx context.Context, hs models.CoincheckHistorySlice) error {
+	for _, h := range hs {
+		h.ID = len(r.Histories) + 1
+		h.PortfolioID = eupholio.PortfolioID(ctx)
+		r.Histories = append(r.Histories, h)
+	}
+	return nil
+}
+
+// CointrackingRepository keeps raw rows of CoinTracking
+type CointrackingRepository struct {
+	Trades models.CointrackingTradeSlice
+}
+
+func (r *CointrackingRepository) FindTrades(ctx context.Context, start, end time.Time) (models.CointrackingTradeSlice, error) {
+	var ts models.CointrackingTradeSlice
+	for _, t := range r.Trades {
+		if rowIn(ctx, t.PortfolioID, t.Date, start, end) {
+			ts = append(ts, t)
+		}
+	}
+	sort.SliceStable(ts, func(i, j int) bool { return ts[i].Date.Before(ts[j].Date) })
+	return ts, nil
+}
+
+// CryptactRepository keeps raw rows of the custom format of Cryptact
+type CryptactRepository struct {
+	Customs models.CryptactCustomSlice
+}
+
+func (r *CryptactRepository) FindCustoms(ctx context.Context, start, end time.Time) (models.CryptactCustomSlice, error) {
+	var cs models.CryptactCustomSlice
+	for _, c := range r.Customs {
+		if rowIn(ctx, c.PortfolioID, c.Timestamp, start, end) {
+			cs = append(cs, c)
+		}
+	}
+	sort.SliceStable(cs, func(i, j int) bool { return cs[i].Timestamp.Before(cs[j].Timestamp) })
+	return cs, nil
+}
+
+// KoinlyRepository keeps raw rows of Koinly
+type KoinlyRepository struct {
+	Transactions models.KoinlyTransactionSlice
+}
+
+func (r *KoinlyRepository) FindTransactions(ctx context.Context, start, end time.Time) (models.KoinlyTransactionSlice, error) {
+	var trs models.KoinlyTransactionSlice
+	for _, tr := range r.Transactions {
+		if rowIn(ctx, tr.PortfolioID, tr.Date, start, end) {
+			trs = append(trs, tr)
+		}
+	}
+	sort.SliceStable(trs, func(i, j int) bool { return trs[i].Date.Before(trs[j].Date) })
+	return trs, nil
+}
+
+// LedgerRepository keeps raw rows of the eupholio ledger format
+type LedgerRepository struct {
+	Entries models.LedgerEntrySlice
+}
+
+func (r *LedgerRepository) FindEntries(ctx context.Context, start, end time.Time) (models.LedgerEntrySlice, error) {
+	var es models.LedgerEntrySlice
+	for _, e := range r.Entries {
+		if rowIn(ctx, e.PortfolioID, e.Time, start, end) {
+			es = append(es, e)
+		}
+	}
+	sort.SliceStable(es, func(i, j int) bool {
+		if es[i].Time.Equal(es[j].Time) {
+			return es[i].ID < es[j].ID
+		}
+		return es[i].Time.Before(es[j].Time)
+	})
+	return es, nil
+}
+
+// PoloniexRepository keeps raw rows of Poloniex
+type PoloniexRepository struct {
+	Trades        models.PoloniexTradeSlice
+	Deposits      models.PoloniexDepositSlice
+	Withdrawals   models.PoloniexWithdrawalSlice
+	Distributions models.PoloniexDistributionSlice
+	Lendings      models.PoloniexLendingSlice
+	Borrowings    models.PoloniexBorrowingSlice
+}
+
+func (r *PoloniexRepository) FindTrades(ctx context.Context, start, end time.Time) (models.PoloniexTradeSlice, error) {
+	var ts models.PoloniexTradeSlice
+	for _, t := range r.Trades {
+		if rowIn(ctx, t.PortfolioID, t.Date, start, end) {
+			ts = append(ts, t)
+		}
+	}
+	sort.SliceStable(ts, func(i, j int) bool { return ts[i].Date.Before(ts[j].Date) })
+	return ts, nil
+}
+
+func (r *PoloniexRepository) FindDeposits(ctx context.Context, start, end time.Time) (models.PoloniexDepositSlice, error) {
+	var ds models.PoloniexDepositSlice
+	for _, d := range r.Deposits {
+		if rowIn(ctx, d.PortfolioID, d.Date, start, end) {
+			ds = append(ds, d)
+		}
+	}
+	sort.SliceStable(ds, func(i, j int) bool { return ds[i].Date.Before(ds[j].Date) })
+	return ds, nil
+}
+
+func (r *PoloniexRepository) FindWithdrawals(ctx context.Context, start, end time.Time) (models.PoloniexWithdrawalSlice, error) {
+	var ws models.PoloniexWithdrawalSlice
+	for _, w := range r.Withdrawals {
+		if rowIn(ctx, w.PortfolioID, w.Date, start, end) {
+			ws = append(ws, w)
+		}
+	}
+	sort.SliceStable(ws, func(i, j int) bool { return ws[i].Date.Before(ws[j].Date) })
+	return ws, nil
+}
+
+func (r *PoloniexRepository) FindDistributions(ctx context.Context, start, end time.Time) (models.PoloniexDistributionSlice, error) {
+	var ds models.PoloniexDistributionSlice
+	for _, d := range r.Distributions {
+		if rowIn(ctx, d.PortfolioID, d.Date, start, end) {
+			ds = append(ds, d)
+		}
+	}
+	sort.SliceStable(ds, func(i, j int) bool { return ds[i].Date.Before(ds[j].Date) })
+	return ds, nil
+}
+
+func (r *PoloniexRepository) FindLendings(ctx context.Context, start, end time.Time) (models.PoloniexLendingSlice, error) {
+	var ls models.PoloniexLendingSlice
+	for _, l := range r.Lendings {
+		if rowIn(ctx, l.PortfolioID, l.Close, start, end) {
+			ls = append(ls, l)
+		}
+	}
+	sort.SliceStable(ls, func(i, j int) bool { return ls[i].Close.Before(ls[j].Close) })
+	return ls, nil
+}
+
+func (r *PoloniexRepository) FindBorrowings(ctx context.Context, start, end time.Time) (models.PoloniexBorrowingSlice, error) {
+	var bs models.PoloniexBorrowingSlice
+	for _, b := range r.Borrowings {
+		if rowIn(ctx, b.PortfolioID, b.Close, start, end) {
+			bs = append(bs, b)
+		}
+	}
+	sort.SliceStable(bs, func(i, j int) bool { return bs[i].Close.Before(bs[j].Close) })
+	return bs, nil
+}
@@ -0,0 +1,427 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package memory implements repositories which keep data in memory.
+// They are used to translate and calculate without a database, and are not safe for concurrent use.
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/currency"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+)
+
+// Repository is an eupholio.Repository which keeps configs, transactions, events, entries, market prices and balances in memory
+type Repository struct {
+	baseCurrency currency.Symbol
+	lastIDs      map[string]int
+
+	configs      models.ConfigSlice
+	transactions models.TransactionSlice
+	events       models.EventSlice
+	entries      models.EntrySlice
+	marketPrices models.MarketPriceSlice
+	balances     models.BalanceSlice
+}
+
+var _ eupholio.Repository = (*Repository)(nil)
+
+// New creates an empty repository whose market prices are in the base currency
+func New(baseCurrency currency.Symbol) *Repository {
+	return &Repository{
+		baseCurrency: baseCurrency,
+		lastIDs:      make(map[string]int),
+	}
+}
+
+func (r *Repository) nextID(table string) int {
+	r.lastIDs[table]++
+	return r.lastIDs[table]
+}
+
+func inPeriod(t, start, end time.Time) bool {
+	return !t.Before(start) && t.Before(end)
+}
+
+func yearPeriod(year int, loc *time.Location) (time.Time, time.Time) {
+	return time.Date(year, time.January, 1, 0, 0, 0, 0, loc), time.Date(year+1, time.January, 1, 0, 0, 0, 0, loc)
+}
+
+// Config
+
+// SetConfig stores a config of the portfolio for the year of the config, replacing the existing one
+func (r *Repository) SetConfig(ctx context.Context, config *models.Config) {
+	c := *config
+	c.PortfolioID = eupholio.PortfolioID(ctx)
+	for i, old := range r.configs {
+		if old.PortfolioID == c.PortfolioID && old.Year == c.Year {
+			r.configs[i] = &c
+			return
+		}
+	}
+	r.configs = append(r.configs, &c)
+}
+
+func (r *Repository) FindConfigByYear(ctx context.Context, year int) (*models.Config, error) {
+	var found *models.Config
+	for _, c := range r.configs {
+		if c.PortfolioID == eupholio.PortfolioID(ctx) && c.Year <= year && (found == nil || c.Year > found.Year) {
+			found = c
+		}
+	}
+	if found == nil {
+		return eupholio.NewDefaultConfig(ctx, year), nil
+	}
+	c := *found
+	return &c, nil
+}
+
+// Transaction
+
+func (r *Repository) CreateTransaction(ctx context.Context, time time.Time, walletCode, account string, walletTid int) (*models.Transaction, error) {
+	transaction := &models.Transaction{
+		ID:          r.nextID(models.TableNames.Transactions),
+		Time:        time,
+		WalletCode:  walletCode,
+		WalletTid:   walletTid,
+		PortfolioID: eupholio.PortfolioID(ctx),
+		Account:     account,
+	}
+	t := *transaction
+	r.transactions = append(r.transactions, &t)
+	return transaction, nil
+}
+
+func (r *Repository) UpdateTransaction(ctx context.Context, transaction *models.Transaction) error {
+	for i, t := range r.transactions {
+		if t.ID == transaction.ID {
+			u := *transaction
+			r.transactions[i] = &u
+			return nil
+		}
+	}
+	return sql.ErrNoRows
+}
+
+// DeleteTransaction deletes transactions of the wallet code in the period and their events
+func (r *Repository) DeleteTransaction(ctx context.Context, walletCode string, start, end time.Time) (int64, error) {
+	return r.DeleteTransactions(ctx, r.findTransactions(ctx, func(t *models.Transaction) bool {
+		return t.WalletCode == walletCode && inPeriod(t.Time, start, end)
+	}))
+}
+
+// DeleteTransactions deletes transactions and their events
+func (r *Repository) DeleteTransactions(ctx context.Context, transactions models.TransactionSlice) (int64, error) {
+	ids := make(map[int]bool)
+	for _, t := range transactions {
+		ids[t.ID] = true
+	}
+	events := r.events[:0]
+	for _, e := range r.events {
+		if !(e.PortfolioID == eupholio.PortfolioID(ctx) && ids[e.TransactionID]) {
+			events = append(events, e)
+		}
+	}
+	r.events = events
+
+	var n int64
+	kept := r.transactions[:0]
+	for _, t := range r.transactions {
+		if t.PortfolioID == eupholio.PortfolioID(ctx) && ids[t.ID] {
+			n++
+			continue
+		}
+		kept = append(kept, t)
+	}
+	r.transactions = kept
+	return n, nil
+}
+
+func (r *Repository) findTransactions(ctx context.Context, match func(t *models.Transaction) bool) models.TransactionSlice {
+	var ts models.TransactionSlice
+	for _, t := range r.transactions {
+		if t.PortfolioID == eupholio.PortfolioID(ctx) && match(t) {
+			c := *t
+			ts = append(ts, &c)
+		}
+	}
+	sort.SliceStable(ts, func(i, j int) bool {
+		if ts[i].Time.Equal(ts[j].Time) {
+			return ts[i].ID < ts[j].ID
+		}
+		return ts[i].Time.Before(ts[j].Time)
+	})
+	return ts
+}
+
+func (r *Repository) FindTransactionsByWalletAndAccount(ctx context.Context, walletCode, account string, start, end time.Time) (models.TransactionSlice, error) {
+	return r.findTransactions(ctx, func(t *models.Transaction) bool {
+		return t.WalletCode == walletCode && t.Account == account && inPeriod(t.Time, start, end)
+	}), nil
+}
+
+func (r *Repository) FindTransactionsByYear(ctx context.Context, year int, loc *time.Location) (models.TransactionSlice, error) {
+	start, end := yearPeriod(year, loc)
+	return r.findTransactions(ctx, func(t *models.Transaction) bool {
+		return inPeriod(t.Time, start, end)
+	}), nil
+}
+
+// Event
+
+func (r *Repository) CreateEvents(ctx context.Context, events models.EventSlice) error {
+	for _, event := range events {
+		if event.Quantity.Sign() == 0 {
+			continue
+		}
+		event.ID = r.nextID(models.TableNames.Event)
+		event.PortfolioID = eupholio.PortfolioID(ctx)
+		e := *event
+		r.events = append(r.events, &e)
+	}
+	return nil
+}
+
+func (r *Repository) findEvents(ctx context.Context, match func(e *models.Event) bool) models.EventSlice {
+	var es models.EventSlice
+	for _, e := range r.events {
+		if e.PortfolioID == eupholio.PortfolioID(ctx) && match(e) {
+			c := *e
+			es = append(es, &c)
+		}
+	}
+	sort.SliceStable(es, func(i, j int) bool {
+		if es[i].Time.Equal(es[j].Time) {
+			return es[i].ID < es[j].ID
+		}
+		return es[i].Time.Before(es[j].Time)
+	})
+	return es
+}
+
+func (r *Repository) FindEvents(ctx context.Context) (models.EventSlice, error) {
+	return r.findEvents(ctx, func(*models.Event) bool { return true }), nil
+}
+
+func (r *Repository) FindEventsByYear(ctx context.Context, year int, loc *time.Location) (models.EventSlice, error) {
+	start, end := yearPeriod(year, loc)
+	return r.FindEventsByStartAndEnd(ctx, start, end)
+}
+
+func (r *Repository) FindEventsByStartAndEnd(ctx context.Context, start, end time.Time) (models.EventSlice, error) {
+	return r.findEvents(ctx, func(e *models.Event) bool {
+		return inPeriod(e.Time, start, end)
+	}), nil
+}
+
+// FindEventsByCurrencyStartAndEnd returns events of the currency whose transactions exist
+func (r *Repository) FindEventsByCurrencyStartAndEnd(ctx context.Context, cur currency.Symbol, start, end time.Time) (models.EventSlice, error) {
+	ids := make(map[int]bool)
+	for _, t := range r.transactions {
+		ids[t.ID] = true
+	}
+	return r.findEvents(ctx, func(e *models.Event) bool {
+		return ids[e.TransactionID] && e.Currency == cur.String() && inPeriod(e.Time, start, end)
+	}), nil
+}
+
+// Entry
+
+func (r *Repository) CreateEntries(ctx context.Context, entries models.EntrySlice) error {
+	for _, entry := range entries {
+		entry.ID = r.nextID(models.TableNames.Entry)
+		entry.PortfolioID = eupholio.PortfolioID(ctx)
+		e := *entry
+		r.entries = append(r.entries, &e)
+	}
+	return nil
+}
+
+func (r *Repository) DeleteEntriesByStartAndEnd(ctx context.Context, start, end time.Time) (int64, error) {
+	var n int64
+	kept := r.entries[:0]
+	for _, e := range r.entries {
+		if e.PortfolioID == eupholio.PortfolioID(ctx) && inPeriod(e.Time, start, end) {
+			n++
+			continue
+		}
+		kept = append(kept, e)
+	}
+	r.entries = kept
+	return n, nil
+}
+
+func (r *Repository) UpdateEntries(ctx context.Context, entries models.EntrySlice) error {
+	for _, entry := range entries {
+		updated := false
+		for i, e := range r.entries {
+			if e.ID == entry.ID {
+				u := *entry
+				r.entries[i] = &u
+				updated = true
+				break
+			}
+		}
+		if !updated {
+			log.Println("failed to update", entry)
+			return sql.ErrNoRows
+		}
+	}
+	return nil
+}
+
+func (r *Repository) FindEntriesByYear(ctx context.Context, year int, loc *time.Location) (models.EntrySlice, error) {
+	start, end := yearPeriod(year, loc)
+	return r.FindEntriesByStartAndEnd(ctx, start, end)
+}
+
+func (r *Repository) FindEntriesByStartAndEnd(ctx context.Context, start, end time.Time) (models.EntrySlice, error) {
+	var es models.EntrySlice
+	for _, e := range r.entries {
+		if e.PortfolioID == eupholio.PortfolioID(ctx) && inPeriod(e.Time, start, end) {
+			c := *e
+			es = append(es, &c)
+		}
+	}
+	sort.SliceStable(es, func(i, j int) bool {
+		if es[i].Time.Equal(es[j].Time) {
+			return es[i].ID < es[j].ID
+		}
+		return es[i].Time.Before(es[j].Time)
+	})
+	return es, nil
+}
+
+// Market Price
+
+func (r *Repository) CreateMarketPrices(ctx context.Context, marketPrices models.MarketPriceSlice) error {
+	for _, marketPrice := range marketPrices {
+		p := *marketPrice
+		r.marketPrices = append(r.marketPrices, &p)
+	}
+	return nil
+}
+
+func (r *Repository) AppendMarketPrices(ctx context.Context, marketPrices models.MarketPriceSlice) error {
+	if len(marketPrices) == 0 {
+		return nil
+	}
+	sort.SliceStable(marketPrices, func(i, j int) bool {
+		return marketPrices[i].Time.Before(marketPrices[j].Time)
+	})
+	if latest, err := r.FindLatestMarketPriceByCurrency(ctx, marketPrices[0].Currency); err == nil {
+		index := sort.Search(len(marketPrices), func(i int) bool {
+			return marketPrices[i].Time.After(latest.Time)
+		})
+		marketPrices = marketPrices[index:]
+	}
+	return r.CreateMarketPrices(ctx, marketPrices)
+}
+
+func (r *Repository) FindLatestMarketPriceByCurrency(ctx context.Context, currency string) (*models.MarketPrice, error) {
+	var found *models.MarketPrice
+	for _, p := range r.marketPrices {
+		if p.BaseCurrency == r.baseCurrency.String() && p.Currency == currency && (found == nil || p.Time.After(found.Time)) {
+			found = p
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("no market price found for %s/%s", currency, r.baseCurrency)
+	}
+	p := *found
+	return &p, nil
+}
+
+func (r *Repository) FindMarketPriceByCurrencyAndTime(ctx context.Context, currency string, tm time.Time) (*models.MarketPrice, error) {
+	defaults := eupholio.DefaultsOf(ctx)
+	var found *models.MarketPrice
+	for _, p := range r.marketPrices {
+		if p.BaseCurrency != r.baseCurrency.String() || p.Currency != currency || !inPeriod(p.Time, tm, tm.Add(time.Hour*48)) {
+			continue
+		}
+		if found == nil {
+			found = p
+			continue
+		}
+		priority, foundPriority := defaults.PriceSourcePriority(p.Source), defaults.PriceSourcePriority(found.Source)
+		if priority < foundPriority || priority == foundPriority && p.Time.Before(found.Time) {
+			found = p
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("no market price found for %s/%s at %s", currency, r.baseCurrency, tm.Format(time.RFC3339))
+	}
+	p := *found
+	return &p, nil
+}
+
+// Balance
+
+func (r *Repository) CreateBalances(ctx context.Context, balances models.BalanceSlice) error {
+	for _, balance := range balances {
+		balance.ID = r.nextID(models.TableNames.Balance)
+		balance.PortfolioID = eupholio.PortfolioID(ctx)
+		b := *balance
+		r.balances = append(r.balances, &b)
+	}
+	return nil
+}
+
+func (r *Repository) DeleteBalancesByYear(ctx context.Context, year int) (int64, error) {
+	var n int64
+	kept := r.balances[:0]
+	for _, b := range r.balances {
+		if b.PortfolioID == eupholio.PortfolioID(ctx) && b.Year == year {
+			n++
+			continue
+		}
+		kept = append(kept, b)
+	}
+	r.balances = kept
+	return n, nil
+}
+
+func (r *Repository) FindBalanceByCurrencyAndYear(ctx context.Context, currency string, year int) (*models.Balance, error) {
+	for _, b := range r.balances {
+		if b.PortfolioID == eupholio.PortfolioID(ctx) && b.Currency == currency && b.Year == year {
+			c := *b
+			return &c, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (r *Repository) FindBalancesByYear(ctx context.Context, year int) (models.BalanceSlice, error) {
+	var bs models.BalanceSlice
+	for _, b := range r.balances {
+		if b.PortfolioID == eupholio.PortfolioID(ctx) && b.Year == year {
+			c := *b
+			bs = append(bs, &c)
+		}
+	}
+	return bs, nil
+}
@@ -0,0 +1,94 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/costmethod"
+	"github.com/eupholio/eupholio/pkg/costmethod/wam"
+	"github.com/eupholio/eupholio/pkg/currency"
+	"github.com/eupholio/eupholio/pkg/ledger"
+)
+
+func ledgerEntry(tid string, tm time.Time, typ string, quantity, jpy int64) *models.LedgerEntry {
+	return &models.LedgerEntry{
+		Version:         1,
+		Tid:             tid,
+		Time:            tm,
+		Wallet:          "OTC",
+		Type:            typ,
+		Currency:        "BTC",
+		Quantity:        types.NewDecimal(decimal.New(quantity, 1)),
+		CounterCurrency: "JPY",
+		CounterQuantity: types.NewNullDecimal(decimal.New(jpy, 0)),
+		FeeQuantity:     types.NewDecimal(decimal.New(0, 0)),
+	}
+}
+
+func TestTranslateAndCalculate(t *testing.T) {
+	ctx := context.Background()
+	jst := time.FixedZone("JST", 9*60*60)
+	raw := &LedgerRepository{
+		Entries: models.LedgerEntrySlice{
+			ledgerEntry("2", time.Date(2020, 2, 1, 0, 0, 0, 0, jst), "sell", 5, 600000),
+			ledgerEntry("1", time.Date(2020, 1, 2, 0, 0, 0, 0, jst), "buy", 10, 1000000),
+			ledgerEntry("3", time.Date(2021, 1, 1, 0, 0, 0, 0, jst), "buy", 10, 1000000),
+		},
+	}
+	for i, e := range raw.Entries {
+		e.ID = i + 1
+	}
+	repo := New(currency.JPY)
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, jst)
+	end := time.Date(2021, 1, 1, 0, 0, 0, 0, jst)
+	if err := ledger.NewTranslator(raw, currency.JPY).Translate(ctx, repo, start, end); err != nil {
+		t.Fatal(err)
+	}
+	transactions, err := repo.FindTransactionsByYear(ctx, 2020, jst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(transactions) != 2 || transactions[0].Description == "" || !transactions[0].Time.Before(transactions[1].Time) {
+		t.Fatalf("unexpected transactions %v", transactions)
+	}
+
+	if err := costmethod.CalculateFiatPrice(ctx, repo, 2020, jst, currency.JPY); err != nil {
+		t.Fatal(err)
+	}
+	if err := costmethod.UpdateBalanceByYear(ctx, repo, 2020, jst, currency.JPY, wam.NewCalculator()); err != nil {
+		t.Fatal(err)
+	}
+	balance, err := repo.FindBalanceByCurrencyAndYear(ctx, "BTC", 2020)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance.Quantity.Big.Cmp(decimal.New(5, 1)) != 0 || balance.Profit.Big.Cmp(decimal.New(100000, 0)) != 0 {
+		t.Errorf("unexpected balance: quantity %s profit %s", balance.Quantity.Big, balance.Profit.Big)
+	}
+}
@@ -29,37 +29,79 @@ import (
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 
 	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/eupholio"
 )
 
 // Transaction
 
+// DeleteTransaction deletes transactions of the wallet code in the period and their events
 func (r *repository) DeleteTransaction(ctx context.Context, walletCode string, start, end time.Time) (int64, error) {
-	s := start.Format(timeFormat)
-	e := end.Format(timeFormat)
-	n, err := models.Transactions(
+	s := start.UTC().Format(timeFormat)
+	e := end.UTC().Format(timeFormat)
+	transactions, err := models.Transactions(
+		eupholio.InPortfolio(ctx),
 		qm.Where("wallet_code = ? AND time >= ? AND time < ?", walletCode, s, e),
-	).DeleteAll(ctx, r.ContextExecutor)
+	).All(ctx, r.ContextExecutor)
 	if err != nil {
 		return -1, err
 	}
-	return n, err
+	return r.DeleteTransactions(ctx, transactions)
 }
 
-func (r *repository) CreateTransaction(ctx context.Context, time time.Time, walletCode string, walletTid int) (*models.Transaction, error) {
+func (r *repository) CreateTransaction(ctx context.Context, time time.Time, walletCode, account string, walletTid int) (*models.Transaction, error) {
 	transaction := &models.Transaction{
-		Time:       time,
-		WalletCode: walletCode,
-		WalletTid:  walletTid,
+		Time:        time,
+		WalletCode:  walletCode,
+		WalletTid:   walletTid,
+		PortfolioID: eupholio.PortfolioID(ctx),
+		Account:     account,
 	}
 	return transaction, transaction.Insert(ctx, r.ContextExecutor, boil.Infer())
 }
 
+func (r *repository) UpdateTransaction(ctx context.Context, transaction *models.Transaction) error {
+	_, err := transaction.Update(ctx, r.ContextExecutor, boil.Infer())
+	return err
+}
+
+// DeleteTransactions deletes transactions and their events
+func (r *repository) DeleteTransactions(ctx context.Context, transactions models.TransactionSlice) (int64, error) {
+	if len(transactions) == 0 {
+		return 0, nil
+	}
+	ids := make([]interface{}, 0, len(transactions))
+	for _, t := range transactions {
+		ids = append(ids, t.ID)
+	}
+	if _, err := models.Events(
+		eupholio.InPortfolio(ctx),
+		qm.WhereIn("transaction_id IN ?", ids...),
+	).DeleteAll(ctx, r.ContextExecutor); err != nil {
+		return -1, err
+	}
+	return models.Transactions(
+		eupholio.InPortfolio(ctx),
+		qm.WhereIn("id IN ?", ids...),
+	).DeleteAll(ctx, r.ContextExecutor)
+}
+
+func (r *repository) FindTransactionsByWalletAndAccount(ctx context.Context, walletCode, account string, start, end time.Time) (models.TransactionSlice, error) {
+	s := start.UTC().Format(timeFormat)
+	e := end.UTC().Format(timeFormat)
+	return models.Transactions(
+		eupholio.InPortfolio(ctx),
+		qm.Where("wallet_code = ? AND account = ? AND time >= ? AND time < ?", walletCode, account, s, e),
+		qm.OrderBy("time ASC, id ASC"),
+	).All(ctx, r.ContextExecutor)
+}
+
 func (r *repository) FindTransactionsByYear(ctx context.Context, year int, loc *time.Location) (models.TransactionSlice, error) {
 	start := time.Date(year, time.January, 1, 0, 0, 0, 0, loc).UTC()
 	end := time.Date(year+1, time.January, 1, 0, 0, 0, 0, loc).UTC()
 	s := start.Format(timeFormat)
 	e := end.Format(timeFormat)
 	es, err := models.Transactions(
+		eupholio.InPortfolio(ctx),
 		qm.Where("time >= ? AND time < ?", s, e),
 		qm.OrderBy("time ASC, id ASC"),
 	).All(ctx, r.ContextExecutor)
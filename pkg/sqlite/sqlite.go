@@ -0,0 +1,125 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package sqlite is the embedded storage backend, which keeps a whole database in a file.
+// The models generated for MySQL work with it because SQLite accepts their quoted identifiers and placeholders.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+	"github.com/mattn/go-sqlite3"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/eupholio/eupholio/pkg/migration"
+)
+
+// DriverName is the name of the driver registered to database/sql
+const DriverName = "eupholio-sqlite3"
+
+// timeFormat is the format of DATETIME of MySQL, which times are stored in
+const timeFormat = "2006-01-02 15:04:05"
+
+func init() {
+	sql.Register(DriverName, &Driver{})
+}
+
+// Driver is the SQLite driver which stores times in UTC in the format of DATETIME of MySQL,
+// so that times stored and compared in queries are ordered as they are in MySQL, and decimals
+// as text in the scale of DECIMAL(20, 10)
+type Driver struct {
+	sqlite3.SQLiteDriver
+}
+
+// Open opens a connection
+func (d *Driver) Open(dsn string) (driver.Conn, error) {
+	c, err := d.SQLiteDriver.Open(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &conn{c.(*sqlite3.SQLiteConn)}, nil
+}
+
+type conn struct {
+	*sqlite3.SQLiteConn
+}
+
+// CheckNamedValue converts times to UTC DATETIME and decimals to DECIMAL(20, 10)
+func (c *conn) CheckNamedValue(nv *driver.NamedValue) error {
+	switch d := nv.Value.(type) {
+	case types.Decimal:
+		nv.Value = formatDecimal(d.Big)
+		return nil
+	case types.NullDecimal:
+		if d.Big == nil {
+			nv.Value = nil
+		} else {
+			nv.Value = formatDecimal(d.Big)
+		}
+		return nil
+	}
+	if valuer, ok := nv.Value.(driver.Valuer); ok {
+		v, err := valuer.Value()
+		if err != nil {
+			return err
+		}
+		nv.Value = v
+	}
+	if t, ok := nv.Value.(time.Time); ok {
+		nv.Value = t.UTC().Format(timeFormat)
+		return nil
+	}
+	v, err := driver.DefaultParameterConverter.ConvertValue(nv.Value)
+	if err != nil {
+		return err
+	}
+	nv.Value = v
+	return nil
+}
+
+func formatDecimal(x *decimal.Big) string {
+	return fmt.Sprintf("%.10f", x)
+}
+
+// Open opens a database file. A new database is migrated to the latest version, and
+// the existing one is migrated by etl db migrate.
+func Open(path string) (*sql.DB, error) {
+	db, err := sql.Open(DriverName, path)
+	if err != nil {
+		return nil, err
+	}
+	// SQLite allows only one writer, and statements of other connections fail while a transaction is written
+	db.SetMaxOpenConns(1)
+	ctx := context.Background()
+	initialized, err := migration.Initialized(ctx, db, migration.SQLite)
+	if err == nil && !initialized {
+		_, err = migration.Migrate(ctx, db, migration.SQLite, 0)
+	}
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to create tables in %s: %w", path, err)
+	}
+	return db, nil
+}
@@ -0,0 +1,86 @@
+/*
+ * Eupholio - A portfolio tracker tool for cryptocurrency
+ * Copyright (C) 2021 Kiyoshi Nakao
+ *
+ * This file is part of Eupholio.
+ *
+ * Eupholio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * Eupholio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Eupholio.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package sqlite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ericlagergren/decimal"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/eupholio/eupholio/models"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+	"github.com/eupholio/eupholio/pkg/repository"
+)
+
+func TestRepository(t *testing.T) {
+	db, err := Open(":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	ctx := context.Background()
+	repo := repository.New(db, "JPY")
+
+	// 2020-01-01 08:00 in JST is in 2019 in UTC
+	for _, tm := range []time.Time{
+		time.Date(2020, time.January, 1, 8, 0, 0, 0, jst),
+		time.Date(2021, time.January, 1, 8, 0, 0, 0, jst),
+	} {
+		tr, err := repo.CreateTransaction(ctx, tm, "BF", "", 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		q, _ := new(decimal.Big).SetString("12345678901.123456789")
+		err = repo.CreateEvents(ctx, models.EventSlice{{
+			TransactionID: tr.ID,
+			Time:          tm,
+			Type:          "buy",
+			Currency:      "BTC",
+			Quantity:      types.NewDecimal(q),
+			BaseCurrency:  "JPY",
+			BaseQuantity:  types.NewDecimal(new(decimal.Big).SetMantScale(-1, 0)),
+		}})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	events, err := repo.FindEventsByYear(ctx, 2020, jst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || !events[0].Time.Equal(time.Date(2020, time.January, 1, 8, 0, 0, 0, jst)) {
+		t.Fatalf("an event of 2020 expected, got %v", events)
+	}
+	if events[0].Quantity.String() != "12345678901.1234567890" {
+		t.Errorf("decimals must be stored as DECIMAL(20, 10), got %s", events[0].Quantity.String())
+	}
+
+	other := eupholio.WithPortfolio(ctx, &models.Portfolio{ID: 1, Name: "other"})
+	if events, err := repo.FindEventsByYear(other, 2020, jst); err != nil || len(events) != 0 {
+		t.Errorf("events of other portfolios must not be found, got %v %v", events, err)
+	}
+}
@@ -1,5 +1,5 @@
 
-INSERT INTO symbols (symbol, `name`) VALUES 
+INSERT IGNORE INTO symbols (symbol, `name`) VALUES 
  ('42', '42 Coin'),
  ('300', '300 token'),
  ('365', '365Coin'),
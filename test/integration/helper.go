@@ -23,6 +23,11 @@ package main
 import (
 	"context"
 	"database/sql"
+	"os"
+
+	"github.com/eupholio/eupholio/pkg/cmdutil"
+	"github.com/eupholio/eupholio/pkg/migration"
+	"github.com/eupholio/eupholio/pkg/sqlite"
 )
 
 func withRollback(ctx context.Context, db *sql.DB, fn func(tx *sql.Tx)) error {
@@ -35,9 +40,16 @@ func withRollback(ctx context.Context, db *sql.DB, fn func(tx *sql.Tx)) error {
 }
 
 func openDB() (*sql.DB, error) {
+	if os.Getenv("EUPHOLIO_DB_DRIVER") == cmdutil.DriverSQLite {
+		return sqlite.Open(":memory:")
+	}
 	db, err := sql.Open("mysql", "eupholio:eupholio@tcp(localhost:3307)/eupholio?parseTime=true")
 	if err != nil {
 		return nil, err
 	}
+	if _, err := migration.Migrate(context.Background(), db, migration.MySQL, 0); err != nil {
+		db.Close()
+		return nil, err
+	}
 	return db, nil
 }
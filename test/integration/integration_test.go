@@ -30,12 +30,17 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ericlagergren/decimal"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 
+	"github.com/eupholio/eupholio/models"
 	"github.com/eupholio/eupholio/pkg/bitflyer"
 	"github.com/eupholio/eupholio/pkg/currency"
 	"github.com/eupholio/eupholio/pkg/etlcmd"
+	"github.com/eupholio/eupholio/pkg/eupholio"
+	"github.com/eupholio/eupholio/pkg/normalized"
 	"github.com/eupholio/eupholio/pkg/querycmd"
 	"github.com/eupholio/eupholio/pkg/repository"
 	"github.com/eupholio/eupholio/pkg/yahoofinance"
@@ -51,8 +56,7 @@ func TestMain(m *testing.M) {
 	if err != nil {
 		panic(err)
 	}
-	m.Run()
-	os.Exit(0)
+	os.Exit(m.Run())
 }
 
 func TestLoad(t *testing.T) {
@@ -102,7 +106,7 @@ func TestImport(t *testing.T) {
 }
 
 func testImportBitflyer(t *testing.T, ctx context.Context, tx *sql.Tx) {
-	err := etlcmd.ImportBitflyerData(ctx, tx, []string{"../testdata/TradeHistory.csv"}, true)
+	err := etlcmd.ImportData(ctx, tx, "bitflyer", []string{"../testdata/TradeHistory.csv"}, true, false, "trade", "UTC", "")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -119,16 +123,78 @@ func testImportBitflyer(t *testing.T, ctx context.Context, tx *sql.Tx) {
 	//bitflyer.NewTableWriter(os.Stderr).PrintTransactionsShort(ts)
 }
 
+type fakeBitflyerAPI struct {
+	executions []*bitflyer.Execution // from the newest one
+}
+
+func (a *fakeBitflyerAPI) GetExecutions(ctx context.Context, productCode string, page bitflyer.Page) ([]*bitflyer.Execution, error) {
+	var ret []*bitflyer.Execution
+	for _, ex := range a.executions {
+		if (page.Before == 0 || ex.ID < page.Before) && ex.ID > page.After && len(ret) < page.Count {
+			ret = append(ret, ex)
+		}
+	}
+	return ret, nil
+}
+
+func (a *fakeBitflyerAPI) GetDeposits(ctx context.Context, page bitflyer.Page) ([]*bitflyer.Deposit, error) {
+	return nil, nil
+}
+
+func (a *fakeBitflyerAPI) GetWithdrawals(ctx context.Context, page bitflyer.Page) ([]*bitflyer.Deposit, error) {
+	return nil, nil
+}
+
+func (a *fakeBitflyerAPI) GetCoinIns(ctx context.Context, page bitflyer.Page) ([]*bitflyer.CoinIn, error) {
+	return nil, nil
+}
+
+func (a *fakeBitflyerAPI) GetCoinOuts(ctx context.Context, page bitflyer.Page) ([]*bitflyer.CoinOut, error) {
+	return nil, nil
+}
+
+func TestImportBitflyerAPI(t *testing.T) {
+	// times in the CSV files are in JST regardless of the local time zone
+	local := time.Local
+	time.Local = time.FixedZone("JST", 9*60*60)
+	defer func() { time.Local = local }()
+
+	ctx := context.Background()
+	err := withRollback(ctx, db, func(tx *gosql.Tx) {
+		testImportBitflyer(t, ctx, tx)
+		execution := func(id int64, price, size *decimal.Big, date time.Time) *bitflyer.Execution {
+			return &bitflyer.Execution{
+				ID: id, ChildOrderID: "JOR", Side: "BUY", Price: price, Size: size,
+				Commission: new(decimal.Big), ExecDate: bitflyer.Time{Time: date},
+			}
+		}
+		api := &fakeBitflyerAPI{executions: []*bitflyer.Execution{
+			execution(2, decimal.New(1000000, 0), decimal.New(1, 1), time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC)),
+			execution(1, decimal.New(1651314, 0), decimal.New(3, 1), time.Date(2017, 12, 29, 0, 0, 0, 123000000, time.UTC)), // imported from the CSV file
+		}}
+		n, err := bitflyer.NewAPIExtractor(api, []string{"BTC_JPY"}).Store(ctx, bitflyer.NewRepository(tx), "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != 1 {
+			t.Errorf("expected 1 transaction stored but %d", n)
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
 func testImportBittrex(t *testing.T, ctx context.Context, tx *sql.Tx) {
-	err := etlcmd.ImportBittrexData(ctx, tx, []string{"../testdata/BittrexDeposit.csv"}, true, "deposit")
+	err := etlcmd.ImportData(ctx, tx, "bittrex", []string{"../testdata/BittrexDeposit.csv"}, true, false, "deposit", "UTC", "")
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = etlcmd.ImportBittrexData(ctx, tx, []string{"../testdata/BittrexWithdraw.csv"}, true, "withdraw")
+	err = etlcmd.ImportData(ctx, tx, "bittrex", []string{"../testdata/BittrexWithdraw.csv"}, true, false, "withdraw", "UTC", "")
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = etlcmd.ImportBittrexData(ctx, tx, []string{"../testdata/BittrexOrderHistory.csv"}, true, "order")
+	err = etlcmd.ImportData(ctx, tx, "bittrex", []string{"../testdata/BittrexOrderHistory.csv"}, true, false, "order", "UTC", "")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -145,6 +211,161 @@ func TestTranslate(t *testing.T) {
 		if err != nil {
 			t.Error(err)
 		}
+		n, err := models.Transactions().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		events, err := models.Events().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, year := range []int{2017, 2018, 2019} {
+			if err := etlcmd.Translate(ctx, tx, year, jst, currency.JPY); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if m, err := models.Transactions().Count(ctx, tx); err != nil || m != n {
+			t.Errorf("expected %d transactions after translating again but %d: %v", n, m, err)
+		}
+		if m, err := models.Events().Count(ctx, tx); err != nil || m != events {
+			t.Errorf("expected %d events after translating again but %d: %v", events, m, err)
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTranslateDeletedRows(t *testing.T) {
+	ctx := context.Background()
+	err := withRollback(ctx, db, func(tx *gosql.Tx) {
+		testImportBitflyer(t, ctx, tx)
+		if err := etlcmd.Translate(ctx, tx, 0, jst, currency.JPY); err != nil {
+			t.Fatal(err)
+		}
+		translated := models.Transactions(models.TransactionWhere.WalletCode.EQ(bitflyer.WalletCode))
+		n, err := translated.Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		first, err := models.BFTransactions(qm.OrderBy("id ASC")).One(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := first.Delete(ctx, tx); err != nil {
+			t.Fatal(err)
+		}
+		if err := etlcmd.Translate(ctx, tx, 0, jst, currency.JPY); err != nil {
+			t.Fatal(err)
+		}
+		if m, err := translated.Count(ctx, tx); err != nil || m != n-1 {
+			t.Errorf("expected %d transactions but %d: %v", n-1, m, err)
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestImportNormalized(t *testing.T) {
+	ctx := context.Background()
+	err := withRollback(ctx, db, func(tx *gosql.Tx) {
+		for i := 0; i < 2; i++ { // the second import is skipped
+			if err := etlcmd.ImportNormalizedData(ctx, tx, []string{"../testdata/normalized.json"}, false, false, "NORM_BF", ""); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if n, err := models.NormalizedEvents().Count(ctx, tx); err != nil || n != 4 {
+			t.Fatalf("expected 4 events imported but %d: %v", n, err)
+		}
+		translated := models.Transactions(models.TransactionWhere.WalletCode.EQ(normalized.WalletCode), qm.OrderBy("wallet_tid ASC"))
+		var tids [][]int
+		for i := 0; i < 2; i++ { // transactions are identified by the same ids when translated again
+			if err := etlcmd.Translate(ctx, tx, 0, jst, currency.JPY); err != nil {
+				t.Fatal(err)
+			}
+			trs, err := translated.All(ctx, tx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var ids []int
+			for _, tr := range trs {
+				ids = append(ids, tr.WalletTid)
+			}
+			tids = append(tids, ids)
+		}
+		if len(tids[0]) != 3 || fmt.Sprint(tids[0]) != fmt.Sprint(tids[1]) {
+			t.Errorf("expected the same 3 transactions but %v", tids)
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUndoImportBatch(t *testing.T) {
+	ctx := context.Background()
+	err := withRollback(ctx, db, func(tx *gosql.Tx) {
+		testImportBitflyer(t, ctx, tx)
+		if err := etlcmd.Translate(ctx, tx, 0, jst, currency.JPY); err != nil {
+			t.Fatal(err)
+		}
+		translated := models.Transactions(models.TransactionWhere.WalletCode.EQ(bitflyer.WalletCode))
+		if n, err := translated.Count(ctx, tx); err != nil || n == 0 {
+			t.Fatalf("no transactions translated: %v", err)
+		}
+		batch, err := models.ImportBatches(qm.OrderBy("id DESC")).One(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := etlcmd.UndoImportBatch(ctx, tx, batch.ID, jst, currency.JPY); err != nil {
+			t.Fatal(err)
+		}
+		if n, err := translated.Count(ctx, tx); err != nil || n != 0 {
+			t.Errorf("%d transactions left: %v", n, err)
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSetCostMethod(t *testing.T) {
+	ctx := context.Background()
+	err := withRollback(ctx, db, func(tx *gosql.Tx) {
+		corp, err := etlcmd.SetPortfolio(ctx, tx, "corp", "", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		corpCtx := eupholio.WithPortfolio(ctx, corp)
+		if err := etlcmd.SetCostMethod(ctx, tx, 2019, "mam"); err != nil {
+			t.Fatal(err)
+		}
+		if err := etlcmd.SetCostMethod(corpCtx, tx, 2019, "wam"); err != nil {
+			t.Fatal(err)
+		}
+		if err := etlcmd.SetCostMethod(corpCtx, tx, 2020, "mam"); err != nil {
+			t.Fatal(err)
+		}
+		repo := repository.New(tx, currency.JPY)
+		for _, c := range []struct {
+			ctx    context.Context
+			year   int
+			method string
+		}{
+			{ctx, 2019, "mam"},
+			{ctx, 2020, "mam"},
+			{corpCtx, 2019, "wam"},
+			{corpCtx, 2020, "mam"},
+		} {
+			config, err := repo.FindConfigByYear(c.ctx, c.year)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if config.CostMethod != c.method || config.PortfolioID != eupholio.PortfolioID(c.ctx) {
+				t.Errorf("expected %s of portfolio %d in %d but %s of %d", c.method, eupholio.PortfolioID(c.ctx), c.year, config.CostMethod, config.PortfolioID)
+			}
+		}
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -186,7 +407,7 @@ func TestCalculate(t *testing.T) {
 				t.Error(err)
 			}
 			buf := bytes.NewBuffer(nil)
-			querycmd.QueryTransactions(ctx, buf, tx, year, jst, fiat.String(), source, querycmd.OutputFormatTable)
+			querycmd.QueryTransactions(ctx, buf, tx, year, jst, fiat.String(), source, "", querycmd.OutputFormatTable)
 			t.Log("transactions \n", buf.String())
 			bs, err := repo.FindBalancesByYear(ctx, year)
 			if err != nil {